@@ -0,0 +1,629 @@
+package applicationinsights
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationInsightsStandardWebTestModel struct {
+	Name                  string                                `tfschema:"name"`
+	ResourceGroupName     string                                `tfschema:"resource_group_name"`
+	Location              string                                `tfschema:"location"`
+	ApplicationInsightsId string                                `tfschema:"application_insights_id"`
+	GeoLocations          []string                              `tfschema:"geo_locations"`
+	Request               []StandardWebTestRequestModel         `tfschema:"request"`
+	ValidationRules       []StandardWebTestValidationRulesModel `tfschema:"validation_rules"`
+	Description           string                                `tfschema:"description"`
+	Enabled               bool                                  `tfschema:"enabled"`
+	Frequency             int64                                 `tfschema:"frequency"`
+	RetryEnabled          bool                                  `tfschema:"retry_enabled"`
+	Timeout               int64                                 `tfschema:"timeout"`
+	Tags                  map[string]string                     `tfschema:"tags"`
+	SyntheticMonitorId    string                                `tfschema:"synthetic_monitor_id"`
+}
+
+type StandardWebTestRequestModel struct {
+	Url                           string                       `tfschema:"url"`
+	HttpVerb                      string                       `tfschema:"http_verb"`
+	Body                          string                       `tfschema:"body"`
+	Headers                       []StandardWebTestHeaderModel `tfschema:"header"`
+	FollowRedirectsEnabled        bool                         `tfschema:"follow_redirects_enabled"`
+	ParseDependentRequestsEnabled bool                         `tfschema:"parse_dependent_requests_enabled"`
+}
+
+type StandardWebTestHeaderModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type StandardWebTestValidationRulesModel struct {
+	ExpectedStatusCode       int64                         `tfschema:"expected_status_code"`
+	SSLCheckEnabled          bool                          `tfschema:"ssl_check_enabled"`
+	SSLCertRemainingLifetime int64                         `tfschema:"ssl_cert_remaining_lifetime"`
+	ContentValidation        []StandardWebTestContentModel `tfschema:"content"`
+}
+
+type StandardWebTestContentModel struct {
+	ContentMatch    string `tfschema:"content_match"`
+	IgnoreCase      bool   `tfschema:"ignore_case"`
+	PassIfTextFound bool   `tfschema:"pass_if_text_found"`
+}
+
+type ApplicationInsightsStandardWebTestResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationInsightsStandardWebTestResource{}
+
+func (r ApplicationInsightsStandardWebTestResource) ResourceType() string {
+	return "azurerm_application_insights_standard_web_test"
+}
+
+func (r ApplicationInsightsStandardWebTestResource) ModelObject() interface{} {
+	return &ApplicationInsightsStandardWebTestModel{}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webtests.ValidateWebTestID
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"application_insights_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ComponentID,
+		},
+
+		"geo_locations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"request": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"http_verb": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  http.MethodGet,
+						ValidateFunc: validation.StringInSlice([]string{
+							http.MethodDelete,
+							http.MethodGet,
+							http.MethodHead,
+							http.MethodOptions,
+							http.MethodPost,
+							http.MethodPut,
+							http.MethodPatch,
+						}, false),
+					},
+
+					"body": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"header": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"follow_redirects_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"parse_dependent_requests_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"validation_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expected_status_code": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      200,
+						ValidateFunc: validation.IntBetween(0, 599),
+					},
+
+					"ssl_check_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"ssl_cert_remaining_lifetime": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+
+					"content": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_match": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ignore_case": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+
+								"pass_if_text_found": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frequency": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  300,
+			ValidateFunc: validation.IntInSlice([]int{
+				300,
+				600,
+				900,
+			}),
+		},
+
+		"retry_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntBetween(30, 120),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"synthetic_monitor_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApplicationInsightsStandardWebTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := webtests.NewWebTestID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.WebTestsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			webTest, err := expandApplicationInsightsStandardWebTest(id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WebTestsCreateOrUpdate(ctx, id, *webTest); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WebTestsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationInsightsStandardWebTestModel{
+				Name:              id.WebTestName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				// the link to the Application Insights component is only stored as a hidden tag
+				tags := make(map[string]string)
+				if model.Tags != nil {
+					for k, v := range *model.Tags {
+						if strings.HasPrefix(k, "hidden-link:") {
+							state.ApplicationInsightsId = strings.TrimPrefix(k, "hidden-link:")
+							continue
+						}
+						tags[k] = v
+					}
+				}
+				state.Tags = tags
+
+				if props := model.Properties; props != nil {
+					state.SyntheticMonitorId = props.SyntheticMonitorId
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.GeoLocations = flattenApplicationInsightsStandardWebTestGeoLocations(props.Locations)
+					state.Request = flattenApplicationInsightsStandardWebTestRequest(props.Request)
+					state.ValidationRules = flattenApplicationInsightsStandardWebTestValidationRules(props.ValidationRules)
+
+					if props.Enabled != nil {
+						state.Enabled = *props.Enabled
+					}
+					if props.Frequency != nil {
+						state.Frequency = *props.Frequency
+					}
+					if props.RetryEnabled != nil {
+						state.RetryEnabled = *props.RetryEnabled
+					}
+					if props.Timeout != nil {
+						state.Timeout = *props.Timeout
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationInsightsStandardWebTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API doesn't support partial updates of the Web Test properties, so the full payload is sent
+			webTest, err := expandApplicationInsightsStandardWebTest(*id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WebTestsCreateOrUpdate(ctx, *id, *webTest); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if resp, err := client.WebTestsDelete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationInsightsStandardWebTest(id webtests.WebTestId, input ApplicationInsightsStandardWebTestModel) (*webtests.WebTest, error) {
+	componentId, err := parse.ComponentID(input.ApplicationInsightsId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := expandApplicationInsightsStandardWebTestRequest(input.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	validationRules, err := expandApplicationInsightsStandardWebTestValidationRules(input.ValidationRules, request)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for k, v := range input.Tags {
+		tags[k] = v
+	}
+	// the Web Test is linked to the Application Insights component through this tag
+	tags[fmt.Sprintf("hidden-link:%s", componentId.ID())] = "Resource"
+
+	kind := webtests.WebTestKindStandard
+	return &webtests.WebTest{
+		Kind:     &kind,
+		Location: location.Normalize(input.Location),
+		Properties: &webtests.WebTestProperties{
+			Description:        utils.String(input.Description),
+			Enabled:            utils.Bool(input.Enabled),
+			Frequency:          utils.Int64(input.Frequency),
+			Kind:               webtests.WebTestKindStandard,
+			Locations:          expandApplicationInsightsStandardWebTestGeoLocations(input.GeoLocations),
+			Name:               id.WebTestName,
+			Request:            request,
+			RetryEnabled:       utils.Bool(input.RetryEnabled),
+			SyntheticMonitorId: id.WebTestName,
+			Timeout:            utils.Int64(input.Timeout),
+			ValidationRules:    validationRules,
+		},
+		Tags: &tags,
+	}, nil
+}
+
+func expandApplicationInsightsStandardWebTestGeoLocations(input []string) []webtests.WebTestGeolocation {
+	locations := make([]webtests.WebTestGeolocation, 0)
+	for _, v := range input {
+		locations = append(locations, webtests.WebTestGeolocation{
+			Location: utils.String(v),
+		})
+	}
+	return locations
+}
+
+func flattenApplicationInsightsStandardWebTestGeoLocations(input []webtests.WebTestGeolocation) []string {
+	locations := make([]string, 0)
+	for _, v := range input {
+		if v.Location != nil {
+			locations = append(locations, *v.Location)
+		}
+	}
+	return locations
+}
+
+func expandApplicationInsightsStandardWebTestRequest(input []StandardWebTestRequestModel) (*webtests.WebTestPropertiesRequest, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0]
+	headers := make([]webtests.HeaderField, 0)
+	for _, header := range v.Headers {
+		headers = append(headers, webtests.HeaderField{
+			HeaderFieldName:  utils.String(header.Name),
+			HeaderFieldValue: utils.String(header.Value),
+		})
+	}
+
+	request := &webtests.WebTestPropertiesRequest{
+		FollowRedirects:        utils.Bool(v.FollowRedirectsEnabled),
+		HTTPVerb:               utils.String(v.HttpVerb),
+		Headers:                &headers,
+		ParseDependentRequests: utils.Bool(v.ParseDependentRequestsEnabled),
+		RequestUrl:             utils.String(v.Url),
+	}
+
+	if v.Body != "" {
+		if v.HttpVerb == http.MethodGet || v.HttpVerb == http.MethodHead {
+			return nil, fmt.Errorf("`body` cannot be specified when `http_verb` is `%s`", v.HttpVerb)
+		}
+		request.RequestBody = utils.String(v.Body)
+	}
+
+	return request, nil
+}
+
+func flattenApplicationInsightsStandardWebTestRequest(input *webtests.WebTestPropertiesRequest) []StandardWebTestRequestModel {
+	if input == nil {
+		return []StandardWebTestRequestModel{}
+	}
+
+	headers := make([]StandardWebTestHeaderModel, 0)
+	if input.Headers != nil {
+		for _, v := range *input.Headers {
+			headers = append(headers, StandardWebTestHeaderModel{
+				Name:  utils.NormalizeNilableString(v.HeaderFieldName),
+				Value: utils.NormalizeNilableString(v.HeaderFieldValue),
+			})
+		}
+	}
+
+	request := StandardWebTestRequestModel{
+		Url:      utils.NormalizeNilableString(input.RequestUrl),
+		HttpVerb: utils.NormalizeNilableString(input.HTTPVerb),
+		Body:     utils.NormalizeNilableString(input.RequestBody),
+		Headers:  headers,
+	}
+
+	if input.FollowRedirects != nil {
+		request.FollowRedirectsEnabled = *input.FollowRedirects
+	}
+	if input.ParseDependentRequests != nil {
+		request.ParseDependentRequestsEnabled = *input.ParseDependentRequests
+	}
+
+	return []StandardWebTestRequestModel{request}
+}
+
+func expandApplicationInsightsStandardWebTestValidationRules(input []StandardWebTestValidationRulesModel, request *webtests.WebTestPropertiesRequest) (*webtests.WebTestPropertiesValidationRules, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0]
+	isHttps := request != nil && request.RequestUrl != nil && strings.HasPrefix(strings.ToLower(*request.RequestUrl), "https://")
+	if v.SSLCheckEnabled && !isHttps {
+		return nil, fmt.Errorf("`ssl_check_enabled` can only be set to `true` when the `url` uses HTTPS")
+	}
+	if v.SSLCertRemainingLifetime != 0 && !v.SSLCheckEnabled {
+		return nil, fmt.Errorf("`ssl_cert_remaining_lifetime` can only be specified when `ssl_check_enabled` is `true`")
+	}
+
+	rules := &webtests.WebTestPropertiesValidationRules{
+		ExpectedHTTPStatusCode: utils.Int64(v.ExpectedStatusCode),
+		SSLCheck:               utils.Bool(v.SSLCheckEnabled),
+	}
+
+	if v.SSLCertRemainingLifetime != 0 {
+		rules.SSLCertRemainingLifetimeCheck = utils.Int64(v.SSLCertRemainingLifetime)
+	}
+
+	if len(v.ContentValidation) > 0 {
+		content := v.ContentValidation[0]
+		rules.ContentValidation = &webtests.WebTestPropertiesValidationRulesContentValidation{
+			ContentMatch:    utils.String(content.ContentMatch),
+			IgnoreCase:      utils.Bool(content.IgnoreCase),
+			PassIfTextFound: utils.Bool(content.PassIfTextFound),
+		}
+	}
+
+	return rules, nil
+}
+
+func flattenApplicationInsightsStandardWebTestValidationRules(input *webtests.WebTestPropertiesValidationRules) []StandardWebTestValidationRulesModel {
+	if input == nil {
+		return []StandardWebTestValidationRulesModel{}
+	}
+
+	rules := StandardWebTestValidationRulesModel{
+		ContentValidation: []StandardWebTestContentModel{},
+	}
+
+	if input.ExpectedHTTPStatusCode != nil {
+		rules.ExpectedStatusCode = *input.ExpectedHTTPStatusCode
+	}
+	if input.SSLCheck != nil {
+		rules.SSLCheckEnabled = *input.SSLCheck
+	}
+	if input.SSLCertRemainingLifetimeCheck != nil {
+		rules.SSLCertRemainingLifetime = *input.SSLCertRemainingLifetimeCheck
+	}
+
+	if content := input.ContentValidation; content != nil {
+		contentValidation := StandardWebTestContentModel{
+			ContentMatch: utils.NormalizeNilableString(content.ContentMatch),
+		}
+		if content.IgnoreCase != nil {
+			contentValidation.IgnoreCase = *content.IgnoreCase
+		}
+		if content.PassIfTextFound != nil {
+			contentValidation.PassIfTextFound = *content.PassIfTextFound
+		}
+		rules.ContentValidation = []StandardWebTestContentModel{contentValidation}
+	}
+
+	return []StandardWebTestValidationRulesModel{rules}
+}
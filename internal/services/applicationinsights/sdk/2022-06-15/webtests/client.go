@@ -0,0 +1,15 @@
+package webtests
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WebTestsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWebTestsClientWithBaseURI(endpoint string) WebTestsClient {
+	return WebTestsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
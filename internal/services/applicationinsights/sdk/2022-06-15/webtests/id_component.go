@@ -0,0 +1,124 @@
+package webtests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ComponentId{}
+
+// ComponentId is a struct representing the Resource ID for a Component
+type ComponentId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ComponentName     string
+}
+
+// NewComponentID returns a new ComponentId struct
+func NewComponentID(subscriptionId string, resourceGroupName string, componentName string) ComponentId {
+	return ComponentId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ComponentName:     componentName,
+	}
+}
+
+// ParseComponentID parses 'input' into a ComponentId
+func ParseComponentID(input string) (*ComponentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ComponentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ComponentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ComponentName, ok = parsed.Parsed["componentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'componentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseComponentIDInsensitively parses 'input' case-insensitively into a ComponentId
+// note: this method should only be used for API response data and not user input
+func ParseComponentIDInsensitively(input string) (*ComponentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ComponentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ComponentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ComponentName, ok = parsed.Parsed["componentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'componentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateComponentID checks that 'input' can be parsed as a Component ID
+func ValidateComponentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseComponentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Component ID
+func (id ComponentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/components/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ComponentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Component ID
+func (id ComponentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticComponents", "components", "components"),
+		resourceids.UserSpecifiedSegment("componentName", "componentValue"),
+	}
+}
+
+// String returns a human-readable description of this Component ID
+func (id ComponentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Component Name: %q", id.ComponentName),
+	}
+	return fmt.Sprintf("Component (%s)", strings.Join(components, "\n"))
+}
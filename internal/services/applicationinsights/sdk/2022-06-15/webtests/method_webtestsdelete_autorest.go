@@ -0,0 +1,63 @@
+package webtests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type WebTestsDeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// WebTestsDelete ...
+func (c WebTestsClient) WebTestsDelete(ctx context.Context, id WebTestId) (result WebTestsDeleteOperationResponse, err error) {
+	req, err := c.preparerForWebTestsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForWebTestsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForWebTestsDelete prepares the WebTestsDelete request.
+func (c WebTestsClient) preparerForWebTestsDelete(ctx context.Context, id WebTestId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForWebTestsDelete handles the response to the WebTestsDelete request. The method always
+// closes the http.Response Body.
+func (c WebTestsClient) responderForWebTestsDelete(resp *http.Response) (result WebTestsDeleteOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
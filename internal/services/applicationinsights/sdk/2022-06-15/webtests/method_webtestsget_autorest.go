@@ -0,0 +1,65 @@
+package webtests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type WebTestsGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *WebTest
+}
+
+// WebTestsGet ...
+func (c WebTestsClient) WebTestsGet(ctx context.Context, id WebTestId) (result WebTestsGetOperationResponse, err error) {
+	req, err := c.preparerForWebTestsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForWebTestsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForWebTestsGet prepares the WebTestsGet request.
+func (c WebTestsClient) preparerForWebTestsGet(ctx context.Context, id WebTestId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForWebTestsGet handles the response to the WebTestsGet request. The method always
+// closes the http.Response Body.
+func (c WebTestsClient) responderForWebTestsGet(resp *http.Response) (result WebTestsGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
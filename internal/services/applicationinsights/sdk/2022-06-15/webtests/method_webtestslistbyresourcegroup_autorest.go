@@ -0,0 +1,184 @@
+package webtests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+type WebTestsListByResourceGroupOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *[]WebTest
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (WebTestsListByResourceGroupOperationResponse, error)
+}
+
+type WebTestsListByResourceGroupCompleteResult struct {
+	Items []WebTest
+}
+
+func (r WebTestsListByResourceGroupOperationResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r WebTestsListByResourceGroupOperationResponse) LoadMore(ctx context.Context) (resp WebTestsListByResourceGroupOperationResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// WebTestsListByResourceGroup ...
+func (c WebTestsClient) WebTestsListByResourceGroup(ctx context.Context, id commonids.ResourceGroupId) (resp WebTestsListByResourceGroupOperationResponse, err error) {
+	req, err := c.preparerForWebTestsListByResourceGroup(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForWebTestsListByResourceGroup(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// WebTestsListByResourceGroupComplete retrieves all of the results into a single object
+func (c WebTestsClient) WebTestsListByResourceGroupComplete(ctx context.Context, id commonids.ResourceGroupId) (WebTestsListByResourceGroupCompleteResult, error) {
+	return c.WebTestsListByResourceGroupCompleteMatchingPredicate(ctx, id, WebTestOperationPredicate{})
+}
+
+// WebTestsListByResourceGroupCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c WebTestsClient) WebTestsListByResourceGroupCompleteMatchingPredicate(ctx context.Context, id commonids.ResourceGroupId, predicate WebTestOperationPredicate) (resp WebTestsListByResourceGroupCompleteResult, err error) {
+	items := make([]WebTest, 0)
+
+	page, err := c.WebTestsListByResourceGroup(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := WebTestsListByResourceGroupCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForWebTestsListByResourceGroup prepares the WebTestsListByResourceGroup request.
+func (c WebTestsClient) preparerForWebTestsListByResourceGroup(ctx context.Context, id commonids.ResourceGroupId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Insights/webTests", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForWebTestsListByResourceGroupWithNextLink prepares the WebTestsListByResourceGroup request with the given nextLink token.
+func (c WebTestsClient) preparerForWebTestsListByResourceGroupWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForWebTestsListByResourceGroup handles the response to the WebTestsListByResourceGroup request. The method always
+// closes the http.Response Body.
+func (c WebTestsClient) responderForWebTestsListByResourceGroup(resp *http.Response) (result WebTestsListByResourceGroupOperationResponse, err error) {
+	type page struct {
+		Values   []WebTest `json:"value"`
+		NextLink *string   `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result WebTestsListByResourceGroupOperationResponse, err error) {
+			req, err := c.preparerForWebTestsListByResourceGroupWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForWebTestsListByResourceGroup(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "webtests.WebTestsClient", "WebTestsListByResourceGroup", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,6 @@
+package webtests
+
+type HeaderField struct {
+	HeaderFieldName  *string `json:"key,omitempty"`
+	HeaderFieldValue *string `json:"value,omitempty"`
+}
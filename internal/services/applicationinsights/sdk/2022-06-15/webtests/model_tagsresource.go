@@ -0,0 +1,5 @@
+package webtests
+
+type TagsResource struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
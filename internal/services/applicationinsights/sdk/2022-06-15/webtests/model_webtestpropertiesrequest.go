@@ -0,0 +1,10 @@
+package webtests
+
+type WebTestPropertiesRequest struct {
+	FollowRedirects        *bool          `json:"FollowRedirects,omitempty"`
+	HTTPVerb               *string        `json:"HttpVerb,omitempty"`
+	Headers                *[]HeaderField `json:"Headers,omitempty"`
+	ParseDependentRequests *bool          `json:"ParseDependentRequests,omitempty"`
+	RequestBody            *string        `json:"RequestBody,omitempty"`
+	RequestUrl             *string        `json:"RequestUrl,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webtests
+
+type WebTestPropertiesValidationRules struct {
+	ContentValidation             *WebTestPropertiesValidationRulesContentValidation `json:"ContentValidation,omitempty"`
+	ExpectedHTTPStatusCode        *int64                                             `json:"ExpectedHttpStatusCode,omitempty"`
+	IgnoreHTTPSStatusCode         *bool                                              `json:"IgnoreHttpsStatusCode,omitempty"`
+	SSLCertRemainingLifetimeCheck *int64                                             `json:"SSLCertRemainingLifetimeCheck,omitempty"`
+	SSLCheck                      *bool                                              `json:"SSLCheck,omitempty"`
+}
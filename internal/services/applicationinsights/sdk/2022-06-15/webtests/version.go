@@ -0,0 +1,9 @@
+package webtests
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-15"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/webtests/%s", defaultApiVersion)
+}
@@ -1,4 +1,4 @@
-package web_test
+package appservice_test
 
 import (
 	"fmt"
@@ -1,4 +1,4 @@
-package web
+package appservice
 
 import (
 	"context"
@@ -10,10 +10,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	webValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -69,7 +71,7 @@ func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schem
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.AppServiceEnvironmentName,
+			ValidateFunc: webValidate.AppServiceEnvironmentName,
 		},
 
 		"resource_group_name": azure.SchemaResourceGroupName(),
@@ -239,7 +241,7 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Web.AppServiceEnvironmentsClient
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
 			networksClient := metadata.Client.Network.VnetClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
@@ -306,7 +308,7 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 				},
 				MinTimeout:     1 * time.Minute,
 				NotFoundChecks: 20,
-				Refresh:        appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+				Refresh:        helpers.AppServiceEnvironmentRefreshFunc(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
 			}
 
 			timeout, _ := ctx.Deadline()
@@ -336,7 +338,7 @@ func (r AppServiceEnvironmentV3Resource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Web.AppServiceEnvironmentsClient
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
 			id, err := parse.AppServiceEnvironmentID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -398,7 +400,7 @@ func (r AppServiceEnvironmentV3Resource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Web.AppServiceEnvironmentsClient
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
 
 			id, err := parse.AppServiceEnvironmentID(metadata.ResourceData.Id())
 			if err != nil {
@@ -430,7 +432,7 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Web.AppServiceEnvironmentsClient
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
 
 			id, err := parse.AppServiceEnvironmentID(metadata.ResourceData.Id())
 			if err != nil {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	webAuthV2Sdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -9,7 +10,9 @@ type Client struct {
 	AppServiceEnvironmentClient *web.AppServiceEnvironmentsClient
 	BaseClient                  *web.BaseClient
 	ServicePlanClient           *web.AppServicePlansClient
+	StaticSitesClient           *web.StaticSitesClient
 	WebAppsClient               *web.AppsClient
+	WebAppsAuthV2Client         *webAuthV2Sdk.AppsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -22,13 +25,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	webAppServiceClient := web.NewAppsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&webAppServiceClient.Client, o.ResourceManagerAuthorizer)
 
+	webAppsAuthV2Client := webAuthV2Sdk.NewAppsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&webAppsAuthV2Client.Client, o.ResourceManagerAuthorizer)
+
 	servicePlanClient := web.NewAppServicePlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicePlanClient.Client, o.ResourceManagerAuthorizer)
 
+	staticSitesClient := web.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&staticSitesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentClient: &appServiceEnvironmentClient,
 		BaseClient:                  &baseClient,
 		ServicePlanClient:           &servicePlanClient,
+		StaticSitesClient:           &staticSitesClient,
 		WebAppsClient:               &webAppServiceClient,
+		WebAppsAuthV2Client:         &webAppsAuthV2Client,
 	}
 }
@@ -0,0 +1,27 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// AppServiceEnvironmentRefreshFunc returns the Provisioning State of an App Service Environment, which is shared
+// between the v2 and v3 App Service Environment resources
+func AppServiceEnvironmentRefreshFunc(ctx context.Context, client *web.AppServiceEnvironmentsClient, resourceGroup string, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		read, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return "", "", err
+		}
+
+		if read.AppServiceEnvironment == nil {
+			return "", "", fmt.Errorf("`properties` was nil")
+		}
+
+		state := read.AppServiceEnvironment.ProvisioningState
+		return state, string(state), nil
+	}
+}
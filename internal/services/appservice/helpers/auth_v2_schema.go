@@ -0,0 +1,1272 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	webAuthV2Sdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// AuthV2ConfigVersion is the `configVersion` reported by the (legacy) Auth Settings API when the app is using the V2 settings
+const AuthV2ConfigVersion = "v2"
+
+type AuthV2Settings struct {
+	AuthEnabled                        bool                              `tfschema:"auth_enabled"`
+	RuntimeVersion                     string                            `tfschema:"runtime_version"`
+	ConfigFilePath                     string                            `tfschema:"config_file_path"`
+	RequireAuthentication              bool                              `tfschema:"require_authentication"`
+	UnauthenticatedAction              string                            `tfschema:"unauthenticated_action"`
+	DefaultProvider                    string                            `tfschema:"default_provider"`
+	ExcludedPaths                      []string                          `tfschema:"excluded_paths"`
+	RequireHTTPS                       bool                              `tfschema:"require_https"`
+	HttpRouteAPIPrefix                 string                            `tfschema:"http_route_api_prefix"`
+	ForwardProxyConvention             string                            `tfschema:"forward_proxy_convention"`
+	ForwardProxyCustomHostHeaderName   string                            `tfschema:"forward_proxy_custom_host_header_name"`
+	ForwardProxyCustomSchemeHeaderName string                            `tfschema:"forward_proxy_custom_scheme_header_name"`
+	ActiveDirectoryAuth                []AadAuthV2Settings               `tfschema:"active_directory"`
+	AppleAuth                          []AppleAuthV2Settings             `tfschema:"apple"`
+	AzureStaticWebAppAuth              []AzureStaticWebAppAuthV2Settings `tfschema:"azure_static_web_app"`
+	CustomOIDCAuth                     []CustomOIDCAuthV2Settings        `tfschema:"custom_oidc"`
+	FacebookAuth                       []FacebookAuthV2Settings          `tfschema:"facebook"`
+	GithubAuth                         []GithubAuthV2Settings            `tfschema:"github"`
+	GoogleAuth                         []GoogleAuthV2Settings            `tfschema:"google"`
+	MicrosoftAuth                      []MicrosoftAuthV2Settings         `tfschema:"microsoft"`
+	TwitterAuth                        []TwitterAuthV2Settings           `tfschema:"twitter"`
+	Login                              []AuthV2Login                     `tfschema:"login"`
+}
+
+func AuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"auth_settings"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"auth_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the AuthV2 Settings be enabled. Defaults to `false`",
+				},
+
+				"runtime_version": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Default:     "~1",
+					Description: "The Runtime Version of the Authentication and Authorisation feature of this App. Defaults to `~1`",
+				},
+
+				"config_file_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The path to the App Auth settings. **Note:** Relative Paths are evaluated from the Site Root directory.",
+				},
+
+				"require_authentication": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the authentication flow be used for all requests. Defaults to `false`",
+				},
+
+				"unauthenticated_action": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webAuthV2Sdk.UnauthenticatedClientActionV2RedirectToLoginPage),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webAuthV2Sdk.UnauthenticatedClientActionV2AllowAnonymous),
+						string(webAuthV2Sdk.UnauthenticatedClientActionV2RedirectToLoginPage),
+						string(webAuthV2Sdk.UnauthenticatedClientActionV2Return401),
+						string(webAuthV2Sdk.UnauthenticatedClientActionV2Return403),
+					}, false),
+					Description: "The action to take for requests made without authentication. Possible values include `RedirectToLoginPage`, `AllowAnonymous`, `Return401`, and `Return403`. Defaults to `RedirectToLoginPage`.",
+				},
+
+				"default_provider": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Default Authentication Provider to use when the `unauthenticated_action` is set to `RedirectToLoginPage`. Possible values include: `apple`, `azureactivedirectory`, `facebook`, `github`, `google`, `twitter` and the `name` of your `custom_oidc` provider.",
+				},
+
+				"excluded_paths": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The paths which should be excluded from the `unauthenticated_action` when it is set to `RedirectToLoginPage`.",
+				},
+
+				"require_https": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should HTTPS be required on connections? Defaults to `true`.",
+				},
+
+				"http_route_api_prefix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "/.auth",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The prefix that should precede all the authentication and authorisation paths. Defaults to `/.auth`",
+				},
+
+				"forward_proxy_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webAuthV2Sdk.ForwardProxyConventionNoProxy),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webAuthV2Sdk.ForwardProxyConventionNoProxy),
+						string(webAuthV2Sdk.ForwardProxyConventionStandard),
+						string(webAuthV2Sdk.ForwardProxyConventionCustom),
+					}, false),
+					Description: "The convention used to determine the url of the request made. Possible values include `NoProxy`, `Standard`, `Custom`. Defaults to `NoProxy`",
+				},
+
+				"forward_proxy_custom_host_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the header containing the host of the request.",
+				},
+
+				"forward_proxy_custom_scheme_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the header containing the scheme of the request.",
+				},
+
+				"active_directory": AadAuthV2SettingsSchema(),
+
+				"apple": AppleAuthV2SettingsSchema(),
+
+				"azure_static_web_app": AzureStaticWebAppAuthV2SettingsSchema(),
+
+				"custom_oidc": CustomOIDCAuthV2SettingsSchema(),
+
+				"facebook": FacebookAuthV2SettingsSchema(),
+
+				"github": GithubAuthV2SettingsSchema(),
+
+				"google": GoogleAuthV2SettingsSchema(),
+
+				"microsoft": MicrosoftAuthV2SettingsSchema(),
+
+				"twitter": TwitterAuthV2SettingsSchema(),
+
+				"login": AuthV2LoginSchema(),
+			},
+		},
+	}
+}
+
+type AuthV2Login struct {
+	LogoutEndpoint                string   `tfschema:"logout_endpoint"`
+	TokenStoreEnabled             bool     `tfschema:"token_store_enabled"`
+	TokenRefreshExtensionHours    float64  `tfschema:"token_refresh_extension_time"`
+	TokenStorePath                string   `tfschema:"token_store_path"`
+	TokenStoreSasSettingName      string   `tfschema:"token_store_sas_setting_name"`
+	PreserveURLFragmentsForLogins bool     `tfschema:"preserve_url_fragments_for_logins"`
+	AllowedExternalRedirectURLs   []string `tfschema:"allowed_external_redirect_urls"`
+	CookieExpirationConvention    string   `tfschema:"cookie_expiration_convention"`
+	CookieExpirationTime          string   `tfschema:"cookie_expiration_time"`
+	ValidateNonce                 bool     `tfschema:"validate_nonce"`
+	NonceExpirationTime           string   `tfschema:"nonce_expiration_time"`
+}
+
+func AuthV2LoginSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"logout_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The endpoint to which logout requests should be made.",
+				},
+
+				"token_store_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the Token Store configuration Enabled. Defaults to `false`",
+				},
+
+				"token_refresh_extension_time": {
+					Type:        pluginsdk.TypeFloat,
+					Optional:    true,
+					Default:     72,
+					Description: "The number of hours after session token expiration that a session token can be used to call the token refresh API. Defaults to `72` hours.",
+				},
+
+				"token_store_path": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.login.0.token_store_sas_setting_name"},
+					Description:   "The directory path in the App Filesystem in which the tokens will be stored.",
+				},
+
+				"token_store_sas_setting_name": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.login.0.token_store_path"},
+					Description:   "The name of the app setting which contains the SAS URL of the blob storage containing the tokens.",
+				},
+
+				"preserve_url_fragments_for_logins": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the fragments from the request be preserved after the login request is made. Defaults to `false`.",
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "External URLs that can be redirected to as part of logging in or logging out of the app. This is an advanced setting typically only needed by Windows Store application backends. **Note:** URLs within the current domain are always implicitly allowed.",
+				},
+
+				"cookie_expiration_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webAuthV2Sdk.CookieExpirationConventionFixedTime),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webAuthV2Sdk.CookieExpirationConventionFixedTime),
+						string(webAuthV2Sdk.CookieExpirationConventionIdentityProviderDerived),
+					}, false),
+					Description: "The method by which cookies expire. Possible values include: `FixedTime`, and `IdentityProviderDerived`. Defaults to `FixedTime`.",
+				},
+
+				"cookie_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "08:00:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the session cookie should expire. Defaults to `08:00:00`.",
+				},
+
+				"validate_nonce": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the nonce be validated while completing the login flow. Defaults to `true`.",
+				},
+
+				"nonce_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00:05:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the nonce should expire. Defaults to `00:05:00`.",
+				},
+			},
+		},
+	}
+}
+
+type AadAuthV2Settings struct {
+	ClientId                          string            `tfschema:"client_id"`
+	TenantAuthURI                     string            `tfschema:"tenant_auth_endpoint"`
+	ClientSecretSettingName           string            `tfschema:"client_secret_setting_name"`
+	ClientSecretCertificateThumbprint string            `tfschema:"client_secret_certificate_thumbprint"`
+	JWTAllowedGroups                  []string          `tfschema:"jwt_allowed_groups"`
+	JWTAllowedClientApps              []string          `tfschema:"jwt_allowed_client_applications"`
+	WWWAuthDisabled                   bool              `tfschema:"www_authentication_disabled"`
+	AllowedGroups                     []string          `tfschema:"allowed_groups"`
+	AllowedIdentities                 []string          `tfschema:"allowed_identities"`
+	AllowedApplications               []string          `tfschema:"allowed_applications"`
+	LoginParameters                   map[string]string `tfschema:"login_parameters"`
+	AllowedAudiences                  []string          `tfschema:"allowed_audiences"`
+}
+
+func AadAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with Azure Active Directory.",
+				},
+
+				"tenant_auth_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The Azure Tenant Endpoint for the Authenticating Tenant. e.g. `https://login.microsoftonline.com/v2.0/{tenant-guid}/`.",
+				},
+
+				"client_secret_setting_name": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.active_directory.0.client_secret_certificate_thumbprint"},
+					Description:   "The App Setting name that contains the client secret of the Client.",
+				},
+
+				"client_secret_certificate_thumbprint": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.active_directory.0.client_secret_setting_name"},
+					Description:   "The thumbprint of the certificate used for signing purposes.",
+				},
+
+				"jwt_allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Groups in the JWT Claim.",
+				},
+
+				"jwt_allowed_client_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Client Applications in the JWT Claim.",
+				},
+
+				"www_authentication_disabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the www-authenticate provider should be omitted from the request? Defaults to `false`",
+				},
+
+				"allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Group Names for the Default Authorisation Policy.",
+				},
+
+				"allowed_identities": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Identities for the Default Authorisation Policy.",
+				},
+
+				"allowed_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Applications for the Default Authorisation Policy.",
+				},
+
+				"login_parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "A map of key-value pairs to send to the Authorisation Endpoint when a user logs in.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed audience values to consider when validating JWTs issued by Azure Active Directory.",
+				},
+			},
+		},
+	}
+}
+
+type AppleAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func AppleAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Apple web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for Apple Login.",
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+type AzureStaticWebAppAuthV2Settings struct {
+	ClientId string `tfschema:"client_id"`
+}
+
+func AzureStaticWebAppAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with Azure Static Web App Authentication.",
+				},
+			},
+		},
+	}
+}
+
+type CustomOIDCAuthV2Settings struct {
+	Name                        string   `tfschema:"name"`
+	ClientId                    string   `tfschema:"client_id"`
+	ClientSecretSettingName     string   `tfschema:"client_secret_setting_name"`
+	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType               string   `tfschema:"name_claim_type"`
+	Scopes                      []string `tfschema:"scopes"`
+}
+
+func CustomOIDCAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Custom OIDC Authentication Provider.",
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with this Custom OIDC.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The App Setting name that contains the secret for this Custom OIDC Client.",
+				},
+
+				"openid_configuration_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The endpoint that contains all the configuration endpoints for this Custom OIDC provider.",
+				},
+
+				"name_claim_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the claim that contains the users name.",
+				},
+
+				"scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of the scopes that should be requested while authenticating.",
+				},
+			},
+		},
+	}
+}
+
+type FacebookAuthV2Settings struct {
+	AppId                string   `tfschema:"app_id"`
+	AppSecretSettingName string   `tfschema:"app_secret_setting_name"`
+	GraphAPIVersion      string   `tfschema:"graph_api_version"`
+	LoginScopes          []string `tfschema:"login_scopes"`
+}
+
+func FacebookAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The App ID of the Facebook app used for login.",
+				},
+
+				"app_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `app_secret` value used for Facebook Login.",
+				},
+
+				"graph_api_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The version of the Facebook API to be used while logging in.",
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+type GithubAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func GithubAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the GitHub app used for login.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for GitHub Login.",
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+type GoogleAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func GoogleAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Google web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for Google Login.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed Audiences that will be requested as part of Google Sign-In authentication.",
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+type MicrosoftAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func MicrosoftAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OAuth 2.0 client ID that was created for the app used for authentication.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name containing the OAuth 2.0 client secret that was created for the app used for authentication.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed Audiences that will be requested as part of Microsoft Sign-In authentication.",
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+type TwitterAuthV2Settings struct {
+	ConsumerKey               string `tfschema:"consumer_key"`
+	ConsumerSecretSettingName string `tfschema:"consumer_secret_setting_name"`
+}
+
+func TwitterAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"consumer_key": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OAuth 1.0a consumer key of the Twitter application used for sign-in.",
+				},
+
+				"consumer_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
+				},
+			},
+		},
+	}
+}
+
+func authV2LoginScopesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		Description: "The list of Login scopes that will be requested as part of the authentication.",
+	}
+}
+
+// UsesAuthV2 returns whether the (legacy) Auth Settings report that the app has been configured using the V2 settings
+func UsesAuthV2(auth web.SiteAuthSettings) bool {
+	if auth.SiteAuthSettingsProperties == nil {
+		return false
+	}
+
+	return strings.EqualFold(utils.NormalizeNilableString(auth.ConfigVersion), AuthV2ConfigVersion)
+}
+
+// DisabledAuthV1Settings returns the (legacy) Auth Settings payload which disables authentication and reverts the app
+// to using the V1 configuration, used when `auth_settings_v2` is removed.
+func DisabledAuthV1Settings() web.SiteAuthSettings {
+	return web.SiteAuthSettings{
+		SiteAuthSettingsProperties: &web.SiteAuthSettingsProperties{
+			Enabled:       utils.Bool(false),
+			ConfigVersion: utils.String("v1"),
+		},
+	}
+}
+
+func ExpandAuthV2Settings(auth []AuthV2Settings) *webAuthV2Sdk.SiteAuthSettingsV2 {
+	result := &webAuthV2Sdk.SiteAuthSettingsV2{}
+	if len(auth) == 0 {
+		return result
+	}
+
+	v := auth[0]
+
+	props := &webAuthV2Sdk.SiteAuthSettingsV2Properties{
+		Platform: &webAuthV2Sdk.AuthPlatform{
+			Enabled:        utils.Bool(v.AuthEnabled),
+			RuntimeVersion: utils.String(v.RuntimeVersion),
+		},
+		GlobalValidation: &webAuthV2Sdk.GlobalValidation{
+			RequireAuthentication:       utils.Bool(v.RequireAuthentication),
+			UnauthenticatedClientAction: webAuthV2Sdk.UnauthenticatedClientActionV2(v.UnauthenticatedAction),
+		},
+		IdentityProviders: expandAuthV2IdentityProviders(v),
+		Login:             expandAuthV2Login(v.Login),
+		HTTPSettings: &webAuthV2Sdk.HTTPSettings{
+			RequireHTTPS: utils.Bool(v.RequireHTTPS),
+			Routes: &webAuthV2Sdk.HTTPSettingsRoutes{
+				APIPrefix: utils.String(v.HttpRouteAPIPrefix),
+			},
+			ForwardProxy: &webAuthV2Sdk.ForwardProxy{
+				Convention: webAuthV2Sdk.ForwardProxyConvention(v.ForwardProxyConvention),
+			},
+		},
+	}
+
+	if v.ConfigFilePath != "" {
+		props.Platform.ConfigFilePath = utils.String(v.ConfigFilePath)
+	}
+
+	if v.DefaultProvider != "" {
+		props.GlobalValidation.RedirectToProvider = utils.String(v.DefaultProvider)
+	}
+
+	if len(v.ExcludedPaths) > 0 {
+		props.GlobalValidation.ExcludedPaths = &v.ExcludedPaths
+	}
+
+	if v.ForwardProxyCustomHostHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomHostHeaderName = utils.String(v.ForwardProxyCustomHostHeaderName)
+	}
+
+	if v.ForwardProxyCustomSchemeHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomProtoHeaderName = utils.String(v.ForwardProxyCustomSchemeHeaderName)
+	}
+
+	result.SiteAuthSettingsV2Properties = props
+
+	return result
+}
+
+func expandAuthV2Login(input []AuthV2Login) *webAuthV2Sdk.Login {
+	if len(input) == 0 {
+		return nil
+	}
+
+	login := input[0]
+
+	result := &webAuthV2Sdk.Login{
+		Routes: &webAuthV2Sdk.LoginRoutes{},
+		TokenStore: &webAuthV2Sdk.TokenStore{
+			Enabled:                    utils.Bool(login.TokenStoreEnabled),
+			TokenRefreshExtensionHours: utils.Float(login.TokenRefreshExtensionHours),
+		},
+		PreserveURLFragmentsForLogins: utils.Bool(login.PreserveURLFragmentsForLogins),
+		AllowedExternalRedirectUrls:   &login.AllowedExternalRedirectURLs,
+		CookieExpiration: &webAuthV2Sdk.CookieExpiration{
+			Convention:       webAuthV2Sdk.CookieExpirationConvention(login.CookieExpirationConvention),
+			TimeToExpiration: utils.String(login.CookieExpirationTime),
+		},
+		Nonce: &webAuthV2Sdk.Nonce{
+			ValidateNonce:           utils.Bool(login.ValidateNonce),
+			NonceExpirationInterval: utils.String(login.NonceExpirationTime),
+		},
+	}
+
+	if login.LogoutEndpoint != "" {
+		result.Routes.LogoutEndpoint = utils.String(login.LogoutEndpoint)
+	}
+
+	if login.TokenStorePath != "" {
+		result.TokenStore.FileSystem = &webAuthV2Sdk.FileSystemTokenStore{
+			Directory: utils.String(login.TokenStorePath),
+		}
+	}
+
+	if login.TokenStoreSasSettingName != "" {
+		result.TokenStore.AzureBlobStorage = &webAuthV2Sdk.BlobStorageTokenStore{
+			SasURLSettingName: utils.String(login.TokenStoreSasSettingName),
+		}
+	}
+
+	return result
+}
+
+func expandAuthV2IdentityProviders(input AuthV2Settings) *webAuthV2Sdk.IdentityProviders {
+	result := &webAuthV2Sdk.IdentityProviders{
+		CustomOpenIDConnectProviders: map[string]*webAuthV2Sdk.CustomOpenIDConnectProvider{},
+	}
+
+	if len(input.ActiveDirectoryAuth) > 0 {
+		aad := input.ActiveDirectoryAuth[0]
+		registration := &webAuthV2Sdk.AzureActiveDirectoryRegistration{
+			OpenIDIssuer: utils.String(aad.TenantAuthURI),
+			ClientID:     utils.String(aad.ClientId),
+		}
+		if aad.ClientSecretSettingName != "" {
+			registration.ClientSecretSettingName = utils.String(aad.ClientSecretSettingName)
+		}
+		if aad.ClientSecretCertificateThumbprint != "" {
+			registration.ClientSecretCertificateThumbprint = utils.String(aad.ClientSecretCertificateThumbprint)
+		}
+
+		loginParameters := make([]string, 0)
+		for k, v := range aad.LoginParameters {
+			loginParameters = append(loginParameters, fmt.Sprintf("%s=%s", k, v))
+		}
+
+		result.AzureActiveDirectory = &webAuthV2Sdk.AzureActiveDirectory{
+			Enabled:      utils.Bool(true),
+			Registration: registration,
+			Login: &webAuthV2Sdk.AzureActiveDirectoryLogin{
+				LoginParameters:        &loginParameters,
+				DisableWWWAuthenticate: utils.Bool(aad.WWWAuthDisabled),
+			},
+			Validation: &webAuthV2Sdk.AzureActiveDirectoryValidation{
+				JwtClaimChecks: &webAuthV2Sdk.JwtClaimChecks{
+					AllowedGroups:             &aad.JWTAllowedGroups,
+					AllowedClientApplications: &aad.JWTAllowedClientApps,
+				},
+				AllowedAudiences: &aad.AllowedAudiences,
+				DefaultAuthorizationPolicy: &webAuthV2Sdk.DefaultAuthorizationPolicy{
+					AllowedPrincipals: &webAuthV2Sdk.AllowedPrincipals{
+						Groups:     &aad.AllowedGroups,
+						Identities: &aad.AllowedIdentities,
+					},
+					AllowedApplications: &aad.AllowedApplications,
+				},
+			},
+		}
+	}
+
+	if len(input.AppleAuth) > 0 {
+		apple := input.AppleAuth[0]
+		result.Apple = &webAuthV2Sdk.Apple{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.AppleRegistration{
+				ClientID:                utils.String(apple.ClientId),
+				ClientSecretSettingName: utils.String(apple.ClientSecretSettingName),
+			},
+			Login: &webAuthV2Sdk.LoginScopes{
+				Scopes: &apple.LoginScopes,
+			},
+		}
+	}
+
+	if len(input.AzureStaticWebAppAuth) > 0 {
+		result.AzureStaticWebApps = &webAuthV2Sdk.AzureStaticWebApps{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.AzureStaticWebAppsRegistration{
+				ClientID: utils.String(input.AzureStaticWebAppAuth[0].ClientId),
+			},
+		}
+	}
+
+	for _, oidc := range input.CustomOIDCAuth {
+		scopes := oidc.Scopes
+		provider := &webAuthV2Sdk.CustomOpenIDConnectProvider{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.OpenIDConnectRegistration{
+				ClientID: utils.String(oidc.ClientId),
+				ClientCredential: &webAuthV2Sdk.OpenIDConnectClientCredential{
+					Method:                  webAuthV2Sdk.ClientCredentialMethodClientSecretPost,
+					ClientSecretSettingName: utils.String(oidc.ClientSecretSettingName),
+				},
+				OpenIDConnectConfiguration: &webAuthV2Sdk.OpenIDConnectConfig{
+					WellKnownOpenIDConfiguration: utils.String(oidc.OpenIDConfigurationEndpoint),
+				},
+			},
+			Login: &webAuthV2Sdk.OpenIDConnectLogin{
+				Scopes: &scopes,
+			},
+		}
+		if oidc.NameClaimType != "" {
+			provider.Login.NameClaimType = utils.String(oidc.NameClaimType)
+		}
+		result.CustomOpenIDConnectProviders[oidc.Name] = provider
+	}
+
+	if len(input.FacebookAuth) > 0 {
+		facebook := input.FacebookAuth[0]
+		result.Facebook = &webAuthV2Sdk.Facebook{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.AppRegistration{
+				AppID:                utils.String(facebook.AppId),
+				AppSecretSettingName: utils.String(facebook.AppSecretSettingName),
+			},
+			Login: &webAuthV2Sdk.LoginScopes{
+				Scopes: &facebook.LoginScopes,
+			},
+		}
+		if facebook.GraphAPIVersion != "" {
+			result.Facebook.GraphAPIVersion = utils.String(facebook.GraphAPIVersion)
+		}
+	}
+
+	if len(input.GithubAuth) > 0 {
+		github := input.GithubAuth[0]
+		result.GitHub = &webAuthV2Sdk.GitHub{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.ClientRegistration{
+				ClientID:                utils.String(github.ClientId),
+				ClientSecretSettingName: utils.String(github.ClientSecretSettingName),
+			},
+			Login: &webAuthV2Sdk.LoginScopes{
+				Scopes: &github.LoginScopes,
+			},
+		}
+	}
+
+	if len(input.GoogleAuth) > 0 {
+		google := input.GoogleAuth[0]
+		result.Google = &webAuthV2Sdk.Google{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.ClientRegistration{
+				ClientID:                utils.String(google.ClientId),
+				ClientSecretSettingName: utils.String(google.ClientSecretSettingName),
+			},
+			Login: &webAuthV2Sdk.LoginScopes{
+				Scopes: &google.LoginScopes,
+			},
+			Validation: &webAuthV2Sdk.AllowedAudiencesValidation{
+				AllowedAudiences: &google.AllowedAudiences,
+			},
+		}
+	}
+
+	if len(input.MicrosoftAuth) > 0 {
+		microsoft := input.MicrosoftAuth[0]
+		result.LegacyMicrosoftAccount = &webAuthV2Sdk.LegacyMicrosoftAccount{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.ClientRegistration{
+				ClientID:                utils.String(microsoft.ClientId),
+				ClientSecretSettingName: utils.String(microsoft.ClientSecretSettingName),
+			},
+			Login: &webAuthV2Sdk.LoginScopes{
+				Scopes: &microsoft.LoginScopes,
+			},
+			Validation: &webAuthV2Sdk.AllowedAudiencesValidation{
+				AllowedAudiences: &microsoft.AllowedAudiences,
+			},
+		}
+	}
+
+	if len(input.TwitterAuth) > 0 {
+		twitter := input.TwitterAuth[0]
+		result.Twitter = &webAuthV2Sdk.Twitter{
+			Enabled: utils.Bool(true),
+			Registration: &webAuthV2Sdk.TwitterRegistration{
+				ConsumerKey:               utils.String(twitter.ConsumerKey),
+				ConsumerSecretSettingName: utils.String(twitter.ConsumerSecretSettingName),
+			},
+		}
+	}
+
+	return result
+}
+
+func FlattenAuthV2Settings(auth webAuthV2Sdk.SiteAuthSettingsV2) []AuthV2Settings {
+	if auth.SiteAuthSettingsV2Properties == nil {
+		return nil
+	}
+
+	props := *auth.SiteAuthSettingsV2Properties
+
+	result := AuthV2Settings{}
+
+	if platform := props.Platform; platform != nil {
+		result.AuthEnabled = utils.NormaliseNilableBool(platform.Enabled)
+		result.RuntimeVersion = utils.NormalizeNilableString(platform.RuntimeVersion)
+		result.ConfigFilePath = utils.NormalizeNilableString(platform.ConfigFilePath)
+	}
+
+	if global := props.GlobalValidation; global != nil {
+		result.RequireAuthentication = utils.NormaliseNilableBool(global.RequireAuthentication)
+		result.UnauthenticatedAction = string(global.UnauthenticatedClientAction)
+		result.DefaultProvider = utils.NormalizeNilableString(global.RedirectToProvider)
+		result.ExcludedPaths = flattenAuthV2StringSlice(global.ExcludedPaths)
+	}
+
+	if http := props.HTTPSettings; http != nil {
+		result.RequireHTTPS = utils.NormaliseNilableBool(http.RequireHTTPS)
+		if http.Routes != nil {
+			result.HttpRouteAPIPrefix = utils.NormalizeNilableString(http.Routes.APIPrefix)
+		}
+		if proxy := http.ForwardProxy; proxy != nil {
+			result.ForwardProxyConvention = string(proxy.Convention)
+			result.ForwardProxyCustomHostHeaderName = utils.NormalizeNilableString(proxy.CustomHostHeaderName)
+			result.ForwardProxyCustomSchemeHeaderName = utils.NormalizeNilableString(proxy.CustomProtoHeaderName)
+		}
+	}
+
+	if providers := props.IdentityProviders; providers != nil {
+		flattenAuthV2IdentityProviders(*providers, &result)
+	}
+
+	result.Login = flattenAuthV2Login(props.Login)
+
+	return []AuthV2Settings{result}
+}
+
+func flattenAuthV2Login(input *webAuthV2Sdk.Login) []AuthV2Login {
+	if input == nil {
+		return []AuthV2Login{}
+	}
+
+	login := AuthV2Login{
+		PreserveURLFragmentsForLogins: utils.NormaliseNilableBool(input.PreserveURLFragmentsForLogins),
+		AllowedExternalRedirectURLs:   flattenAuthV2StringSlice(input.AllowedExternalRedirectUrls),
+	}
+
+	if routes := input.Routes; routes != nil {
+		login.LogoutEndpoint = utils.NormalizeNilableString(routes.LogoutEndpoint)
+	}
+
+	if tokenStore := input.TokenStore; tokenStore != nil {
+		login.TokenStoreEnabled = utils.NormaliseNilableBool(tokenStore.Enabled)
+		if tokenStore.TokenRefreshExtensionHours != nil {
+			login.TokenRefreshExtensionHours = *tokenStore.TokenRefreshExtensionHours
+		}
+		if tokenStore.FileSystem != nil {
+			login.TokenStorePath = utils.NormalizeNilableString(tokenStore.FileSystem.Directory)
+		}
+		if tokenStore.AzureBlobStorage != nil {
+			login.TokenStoreSasSettingName = utils.NormalizeNilableString(tokenStore.AzureBlobStorage.SasURLSettingName)
+		}
+	}
+
+	if cookie := input.CookieExpiration; cookie != nil {
+		login.CookieExpirationConvention = string(cookie.Convention)
+		login.CookieExpirationTime = utils.NormalizeNilableString(cookie.TimeToExpiration)
+	}
+
+	if nonce := input.Nonce; nonce != nil {
+		login.ValidateNonce = utils.NormaliseNilableBool(nonce.ValidateNonce)
+		login.NonceExpirationTime = utils.NormalizeNilableString(nonce.NonceExpirationInterval)
+	}
+
+	return []AuthV2Login{login}
+}
+
+func flattenAuthV2IdentityProviders(input webAuthV2Sdk.IdentityProviders, result *AuthV2Settings) {
+	if aad := input.AzureActiveDirectory; aad != nil && utils.NormaliseNilableBool(aad.Enabled) {
+		settings := AadAuthV2Settings{}
+		if registration := aad.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			settings.TenantAuthURI = utils.NormalizeNilableString(registration.OpenIDIssuer)
+			settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+			settings.ClientSecretCertificateThumbprint = utils.NormalizeNilableString(registration.ClientSecretCertificateThumbprint)
+		}
+		if login := aad.Login; login != nil {
+			settings.WWWAuthDisabled = utils.NormaliseNilableBool(login.DisableWWWAuthenticate)
+			if login.LoginParameters != nil {
+				params := make(map[string]string)
+				for _, v := range *login.LoginParameters {
+					parts := strings.SplitN(v, "=", 2)
+					if len(parts) != 2 {
+						continue
+					}
+					params[parts[0]] = parts[1]
+				}
+				settings.LoginParameters = params
+			}
+		}
+		if validation := aad.Validation; validation != nil {
+			settings.AllowedAudiences = flattenAuthV2StringSlice(validation.AllowedAudiences)
+			if checks := validation.JwtClaimChecks; checks != nil {
+				settings.JWTAllowedGroups = flattenAuthV2StringSlice(checks.AllowedGroups)
+				settings.JWTAllowedClientApps = flattenAuthV2StringSlice(checks.AllowedClientApplications)
+			}
+			if policy := validation.DefaultAuthorizationPolicy; policy != nil {
+				settings.AllowedApplications = flattenAuthV2StringSlice(policy.AllowedApplications)
+				if principals := policy.AllowedPrincipals; principals != nil {
+					settings.AllowedGroups = flattenAuthV2StringSlice(principals.Groups)
+					settings.AllowedIdentities = flattenAuthV2StringSlice(principals.Identities)
+				}
+			}
+		}
+		result.ActiveDirectoryAuth = []AadAuthV2Settings{settings}
+	}
+
+	if apple := input.Apple; apple != nil && utils.NormaliseNilableBool(apple.Enabled) {
+		settings := AppleAuthV2Settings{}
+		if registration := apple.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+		}
+		if apple.Login != nil {
+			settings.LoginScopes = flattenAuthV2StringSlice(apple.Login.Scopes)
+		}
+		result.AppleAuth = []AppleAuthV2Settings{settings}
+	}
+
+	if swa := input.AzureStaticWebApps; swa != nil && utils.NormaliseNilableBool(swa.Enabled) {
+		settings := AzureStaticWebAppAuthV2Settings{}
+		if swa.Registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(swa.Registration.ClientID)
+		}
+		result.AzureStaticWebAppAuth = []AzureStaticWebAppAuthV2Settings{settings}
+	}
+
+	for name, oidc := range input.CustomOpenIDConnectProviders {
+		if oidc == nil || !utils.NormaliseNilableBool(oidc.Enabled) {
+			continue
+		}
+
+		settings := CustomOIDCAuthV2Settings{
+			Name: name,
+		}
+		if registration := oidc.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			if registration.ClientCredential != nil {
+				settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientCredential.ClientSecretSettingName)
+			}
+			if registration.OpenIDConnectConfiguration != nil {
+				settings.OpenIDConfigurationEndpoint = utils.NormalizeNilableString(registration.OpenIDConnectConfiguration.WellKnownOpenIDConfiguration)
+			}
+		}
+		if login := oidc.Login; login != nil {
+			settings.NameClaimType = utils.NormalizeNilableString(login.NameClaimType)
+			settings.Scopes = flattenAuthV2StringSlice(login.Scopes)
+		}
+		result.CustomOIDCAuth = append(result.CustomOIDCAuth, settings)
+	}
+
+	if facebook := input.Facebook; facebook != nil && utils.NormaliseNilableBool(facebook.Enabled) {
+		settings := FacebookAuthV2Settings{
+			GraphAPIVersion: utils.NormalizeNilableString(facebook.GraphAPIVersion),
+		}
+		if registration := facebook.Registration; registration != nil {
+			settings.AppId = utils.NormalizeNilableString(registration.AppID)
+			settings.AppSecretSettingName = utils.NormalizeNilableString(registration.AppSecretSettingName)
+		}
+		if facebook.Login != nil {
+			settings.LoginScopes = flattenAuthV2StringSlice(facebook.Login.Scopes)
+		}
+		result.FacebookAuth = []FacebookAuthV2Settings{settings}
+	}
+
+	if github := input.GitHub; github != nil && utils.NormaliseNilableBool(github.Enabled) {
+		settings := GithubAuthV2Settings{}
+		if registration := github.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+		}
+		if github.Login != nil {
+			settings.LoginScopes = flattenAuthV2StringSlice(github.Login.Scopes)
+		}
+		result.GithubAuth = []GithubAuthV2Settings{settings}
+	}
+
+	if google := input.Google; google != nil && utils.NormaliseNilableBool(google.Enabled) {
+		settings := GoogleAuthV2Settings{}
+		if registration := google.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+		}
+		if google.Login != nil {
+			settings.LoginScopes = flattenAuthV2StringSlice(google.Login.Scopes)
+		}
+		if google.Validation != nil {
+			settings.AllowedAudiences = flattenAuthV2StringSlice(google.Validation.AllowedAudiences)
+		}
+		result.GoogleAuth = []GoogleAuthV2Settings{settings}
+	}
+
+	if microsoft := input.LegacyMicrosoftAccount; microsoft != nil && utils.NormaliseNilableBool(microsoft.Enabled) {
+		settings := MicrosoftAuthV2Settings{}
+		if registration := microsoft.Registration; registration != nil {
+			settings.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			settings.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+		}
+		if microsoft.Login != nil {
+			settings.LoginScopes = flattenAuthV2StringSlice(microsoft.Login.Scopes)
+		}
+		if microsoft.Validation != nil {
+			settings.AllowedAudiences = flattenAuthV2StringSlice(microsoft.Validation.AllowedAudiences)
+		}
+		result.MicrosoftAuth = []MicrosoftAuthV2Settings{settings}
+	}
+
+	if twitter := input.Twitter; twitter != nil && utils.NormaliseNilableBool(twitter.Enabled) {
+		settings := TwitterAuthV2Settings{}
+		if registration := twitter.Registration; registration != nil {
+			settings.ConsumerKey = utils.NormalizeNilableString(registration.ConsumerKey)
+			settings.ConsumerSecretSettingName = utils.NormalizeNilableString(registration.ConsumerSecretSettingName)
+		}
+		result.TwitterAuth = []TwitterAuthV2Settings{settings}
+	}
+}
+
+func flattenAuthV2StringSlice(input *[]string) []string {
+	if input == nil {
+		return []string{}
+	}
+	return *input
+}
@@ -2,155 +2,217 @@ package helpers
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+// deployment status values reported by the Kudu Deployments API
 const (
-	zipDeployError    = 3
-	zipDeployComplete = 4
+	deploymentStatusFailed  = 3
+	deploymentStatusSuccess = 4
 )
 
-func GetCredentialsAndPublish(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string) error {
+// deploymentLogExcerptLength is the number of (most recent) Kudu log entries included in the error when a deployment fails
+const deploymentLogExcerptLength = 10
+
+// deploymentPollDelay and deploymentPollInterval control how often the status of an in-progress deployment is checked
+var (
+	deploymentPollDelay    = 10 * time.Second
+	deploymentPollInterval = 10 * time.Second
+)
+
+type oneDeployPackageType string
+
+const (
+	oneDeployPackageTypeEar    oneDeployPackageType = "ear"
+	oneDeployPackageTypeJar    oneDeployPackageType = "jar"
+	oneDeployPackageTypeStatic oneDeployPackageType = "static"
+	oneDeployPackageTypeWar    oneDeployPackageType = "war"
+	oneDeployPackageTypeZip    oneDeployPackageType = "zip"
+)
+
+// PublishAppPackage deploys the local package at `sourceFile` to the Site using the OneDeploy API, authenticating with the
+// provider's Azure Active Directory token rather than the Site's publishing (basic auth) credentials.
+func PublishAppPackage(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string) error {
 	site, err := client.Get(ctx, resourceGroup, siteName)
 	if err != nil || site.SiteProperties == nil {
-		return fmt.Errorf("reading site %s to perform zip deploy: %+v", siteName, err)
+		return fmt.Errorf("reading site %s to perform deployment: %+v", siteName, err)
 	}
-	props := *site.SiteProperties
-	if sslStates := props.HostNameSslStates; sslStates != nil {
-		for _, v := range *sslStates {
-			if v.Name != nil && *v.Name != "" && v.HostType == web.HostTypeRepository {
-				user, passwd, err := GetSitePublishingCredentials(ctx, client, resourceGroup, siteName)
-				if err != nil {
-					return err
-				}
-				httpsHost := fmt.Sprintf("https://%s", *v.Name)
 
-				if err := PublishZipDeployLocalFileKuduPush(ctx, httpsHost, *user, *passwd, client.UserAgent, sourceFile); err != nil {
-					return fmt.Errorf("publishing source (%s) to site %s (Resource Group %s): %+v", sourceFile, siteName, resourceGroup, err)
-				}
+	scmHost, err := scmHostForSite(site.SiteProperties)
+	if err != nil {
+		return fmt.Errorf("site %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+	}
 
-				continue
-			}
-		}
-	} else {
-		return fmt.Errorf("could not determine SCM Site name for Site %s (Resource Group %s) for Zip Deployment", siteName, resourceGroup)
+	if err := publishOneDeployPackage(ctx, client, scmHost, sourceFile); err != nil {
+		return fmt.Errorf("publishing source (%s) to site %s (Resource Group %s): %+v", sourceFile, siteName, resourceGroup, err)
 	}
 
 	return nil
 }
 
-func GetCredentialsAndPublishSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string, slotName string) error {
+// PublishAppPackageSlot deploys the local package at `sourceFile` to the Site Slot using the OneDeploy API, authenticating
+// with the provider's Azure Active Directory token rather than the Slot's publishing (basic auth) credentials.
+func PublishAppPackageSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string, slotName string) error {
 	site, err := client.GetSlot(ctx, resourceGroup, siteName, slotName)
 	if err != nil || site.SiteProperties == nil {
-		return fmt.Errorf("reading site %s to perform zip deploy: %+v", siteName, err)
+		return fmt.Errorf("reading slot %s of site %s to perform deployment: %+v", slotName, siteName, err)
 	}
-	props := *site.SiteProperties
-	if sslStates := props.HostNameSslStates; sslStates != nil {
-		for _, v := range *sslStates {
-			if v.Name != nil && *v.Name != "" && v.HostType == web.HostTypeRepository {
-				user, passwd, err := GetSitePublishingCredentialsSlot(ctx, client, resourceGroup, siteName, slotName)
-				if err != nil {
-					return err
-				}
-				httpsHost := fmt.Sprintf("https://%s", *v.Name)
 
-				if err := PublishZipDeployLocalFileKuduPush(ctx, httpsHost, *user, *passwd, client.UserAgent, sourceFile); err != nil {
-					return fmt.Errorf("publishing source (%s) to site %s (Resource Group %s): %+v", sourceFile, siteName, resourceGroup, err)
-				}
+	scmHost, err := scmHostForSite(site.SiteProperties)
+	if err != nil {
+		return fmt.Errorf("slot %s of site %s (Resource Group %s): %+v", slotName, siteName, resourceGroup, err)
+	}
 
-				continue
-			}
-		}
-	} else {
-		return fmt.Errorf("could not determine SCM Site name for Site %s (Resource Group %s) for Zip Deployment", siteName, resourceGroup)
+	if err := publishOneDeployPackage(ctx, client, scmHost, sourceFile); err != nil {
+		return fmt.Errorf("publishing source (%s) to slot %s of site %s (Resource Group %s): %+v", sourceFile, slotName, siteName, resourceGroup, err)
 	}
 
 	return nil
 }
 
-func GetSitePublishingCredentials(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string) (user *string, passwd *string, err error) {
-	siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, resourceGroup, siteName)
+// PackageHash returns the hex encoded SHA256 hash of the contents of the package at `sourceFile`
+func PackageHash(sourceFile string) (string, error) {
+	f, err := os.Open(sourceFile)
 	if err != nil {
-		return nil, nil, fmt.Errorf("listing Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+		return "", err
 	}
-	if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return nil, nil, fmt.Errorf("waiting for Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("hashing %q: %+v", sourceFile, err)
 	}
 
-	siteCredentials, err := siteCredentialsFuture.Result(*client)
-	if err != nil {
-		return nil, nil, fmt.Errorf("reading Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// ZipDeployFileHashCustomizeDiff plans `zip_deploy_file_hash` from the contents of the package at `zip_deploy_file`, so
+// that a new deployment is triggered when the contents of the package change rather than when the path to it changes.
+func ZipDeployFileHashCustomizeDiff(rd *pluginsdk.ResourceDiff) error {
+	if config := rd.GetRawConfig(); config.IsNull() || !config.IsKnown() || config.GetAttr("zip_deploy_file").IsNull() {
+		return nil
 	}
-	if siteCredentials.PublishingUserName == nil || siteCredentials.PublishingPassword == nil {
-		return nil, nil, fmt.Errorf("site credentials for Site %s (Resource Group %s) were empty", siteName, resourceGroup)
+
+	if !rd.NewValueKnown("zip_deploy_file") {
+		return rd.SetNewComputed("zip_deploy_file_hash")
 	}
-	return siteCredentials.PublishingUserName, siteCredentials.PublishingPassword, err
-}
 
-func GetSitePublishingCredentialsSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, slotName string) (user *string, passwd *string, err error) {
-	siteCredentialsFuture, err := client.ListPublishingCredentialsSlot(ctx, resourceGroup, siteName, slotName)
+	hash, err := PackageHash(rd.Get("zip_deploy_file").(string))
 	if err != nil {
-		return nil, nil, fmt.Errorf("listing Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+		if os.IsNotExist(err) {
+			// the package may be created by another resource during the apply
+			return rd.SetNewComputed("zip_deploy_file_hash")
+		}
+		return fmt.Errorf("calculating `zip_deploy_file_hash`: %+v", err)
 	}
-	if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return nil, nil, fmt.Errorf("waiting for Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+
+	if hash == rd.Get("zip_deploy_file_hash").(string) {
+		return nil
 	}
 
-	siteCredentials, err := siteCredentialsFuture.Result(*client)
-	if err != nil {
-		return nil, nil, fmt.Errorf("reading Site Publishing Credential information for %s (Resource Group %s): %+v", siteName, resourceGroup, err)
+	return rd.SetNew("zip_deploy_file_hash", hash)
+}
+
+func scmHostForSite(props *web.SiteProperties) (string, error) {
+	if props.HostNameSslStates != nil {
+		for _, v := range *props.HostNameSslStates {
+			if v.Name != nil && *v.Name != "" && v.HostType == web.HostTypeRepository {
+				return fmt.Sprintf("https://%s", *v.Name), nil
+			}
+		}
 	}
-	if siteCredentials.PublishingUserName == nil || siteCredentials.PublishingPassword == nil {
-		return nil, nil, fmt.Errorf("site credentials for Site %s (Resource Group %s) were empty", siteName, resourceGroup)
+
+	return "", fmt.Errorf("could not determine SCM Site name for deployment")
+}
+
+func oneDeployPackageTypeForFile(sourceFile string) oneDeployPackageType {
+	switch strings.ToLower(filepath.Ext(sourceFile)) {
+	case ".ear":
+		return oneDeployPackageTypeEar
+	case ".jar":
+		return oneDeployPackageTypeJar
+	case ".war":
+		return oneDeployPackageTypeWar
+	case ".zip":
+		return oneDeployPackageTypeZip
+	default:
+		return oneDeployPackageTypeStatic
 	}
-	return siteCredentials.PublishingUserName, siteCredentials.PublishingPassword, err
 }
 
-func PublishZipDeployLocalFileKuduPush(ctx context.Context, host string, user string, passwd string, userAgent string, zipSource string) error {
-	f, err := os.Open(zipSource)
+func publishOneDeployPackage(ctx context.Context, client *web.AppsClient, host string, sourceFile string) error {
+	f, err := os.Open(sourceFile)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", sourceFile, err)
+	}
+
+	packageType := oneDeployPackageTypeForFile(sourceFile)
+	query := url.Values{}
+	query.Set("type", string(packageType))
+	query.Set("async", "true")
+	if packageType == oneDeployPackageTypeStatic {
+		query.Set("path", filepath.Base(sourceFile))
+	}
 
-	publishEndpoint := fmt.Sprintf("%s/api/zipdeploy?isAsync=true", host)
-	statusEndpoint := fmt.Sprintf("%s/api/deployments/latest", host)
+	publishEndpoint := fmt.Sprintf("%s/api/publish?%s", host, query.Encode())
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, publishEndpoint, f)
 	if err != nil {
 		return fmt.Errorf("preparing publish request: %+v", err)
 	}
-
-	req.SetBasicAuth(user, passwd)
+	req.ContentLength = info.Size()
 	req.Header["Cache-Control"] = []string{"no-cache"}
-	req.Header["User-Agent"] = []string{userAgent}
+	req.Header["User-Agent"] = []string{client.UserAgent}
 	req.Header["Content-Type"] = []string{"application/octet-stream"}
 
+	// the request is sent with the default HTTP client rather than the SDK client, since the latter logs (and so buffers)
+	// the entire request body, which can be a large package
+	if req, err = autorest.Prepare(req, client.WithAuthorization()); err != nil {
+		return fmt.Errorf("authorizing publish request: %+v", err)
+	}
+
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("sending publish request: %+v", err)
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		if resp.StatusCode == http.StatusConflict {
-			return fmt.Errorf("publising Zip Deployment failed with %s - Another operation is in progress or your application is not configured for Zip deployments", resp.Status)
+		switch resp.StatusCode {
+		case http.StatusConflict:
+			return fmt.Errorf("publishing %s package failed with %s - another deployment is in progress", packageType, resp.Status)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("publishing %s package failed with %s - the authenticated principal requires permission to deploy to the site", packageType, resp.Status)
 		}
-		return fmt.Errorf("publishing failed with status code %s", resp.Status)
+		return fmt.Errorf("publishing %s package failed with status code %s", packageType, resp.Status)
 	}
 
-	statusReq, err := http.NewRequestWithContext(ctx, http.MethodGet, statusEndpoint, http.NoBody)
+	// the status is polled from the deployment started by this request, rather than `/api/deployments/latest`, since the
+	// latter may still refer to a previous (or concurrent) deployment
+	statusEndpoint, err := deploymentStatusEndpoint(resp)
 	if err != nil {
-		return err
+		return fmt.Errorf("publishing %s package: %+v", packageType, err)
 	}
 
-	statusReq.SetBasicAuth(user, passwd)
 	deadline, ok := ctx.Deadline()
 	if !ok {
 		return fmt.Errorf("publish request context had no deadline")
@@ -159,53 +221,130 @@ func PublishZipDeployLocalFileKuduPush(ctx context.Context, host string, user st
 	deployWait := &pluginsdk.StateChangeConf{
 		Pending:      []string{"pending"},
 		Target:       []string{"complete"},
-		PollInterval: 10 * time.Second,
-		Delay:        10 * time.Second,
+		PollInterval: deploymentPollInterval,
+		Delay:        deploymentPollDelay,
 		Timeout:      time.Until(deadline),
-		Refresh:      checkZipDeploymentStatusRefresh(statusReq),
+		Refresh:      checkDeploymentStatusRefresh(ctx, client, statusEndpoint),
 	}
 
 	if _, err := deployWait.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Zip Deployment to complete")
+		return fmt.Errorf("waiting for deployment to complete: %+v", err)
 	}
 
 	return nil
 }
 
-func checkZipDeploymentStatusRefresh(r *http.Request) pluginsdk.StateRefreshFunc {
+// deploymentStatusEndpoint returns the URL of the deployment started by an async publish request, which is returned in
+// the `Location` header and may be relative to the SCM site
+func deploymentStatusEndpoint(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("response did not include the `Location` of the deployment")
+	}
+
+	locationUrl, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("parsing deployment `Location` %q: %+v", location, err)
+	}
+
+	return resp.Request.URL.ResolveReference(locationUrl).String(), nil
+}
+
+type deploymentStatus struct {
+	Id         string   `json:"id"`
+	Status     *float64 `json:"status"`
+	StatusText string   `json:"status_text"`
+	LogUrl     string   `json:"log_url"`
+}
+
+type deploymentLogEntry struct {
+	Message string `json:"message"`
+}
+
+func checkDeploymentStatusRefresh(ctx context.Context, client *web.AppsClient, statusEndpoint string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		resp, err := http.DefaultClient.Do(r)
-		if err != nil {
-			return nil, "", err
+		status := deploymentStatus{}
+		if err := getKuduJSON(ctx, client, statusEndpoint, &status); err != nil {
+			return nil, "", fmt.Errorf("reading deployment status: %+v", err)
 		}
 
-		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-			return nil, "", fmt.Errorf("failed to read Zip Deployment status: %s", resp.Status)
-		}
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, "", fmt.Errorf("reading status response body for Zip Deploy")
+		if status.Status == nil {
+			return nil, "", fmt.Errorf("could not determine status from deployment response")
 		}
 
-		body := make(map[string]interface{})
-		err = json.Unmarshal(respBody, &body)
-		if err != nil {
-			return nil, "", fmt.Errorf("could not parse status response for Zip Deploy")
+		switch *status.Status {
+		case deploymentStatusFailed:
+			message := fmt.Sprintf("deployment %q failed", status.Id)
+			if status.StatusText != "" {
+				message = fmt.Sprintf("%s: %s", message, status.StatusText)
+			}
+			if excerpt := deploymentLogExcerpt(ctx, client, status.LogUrl); excerpt != "" {
+				message = fmt.Sprintf("%s\n\nDeployment log (most recent entries):\n%s", message, excerpt)
+			}
+			return nil, "", fmt.Errorf("%s", message)
+		case deploymentStatusSuccess:
+			return status, "complete", nil
+		default:
+			return status, "pending", nil
 		}
+	}
+}
 
-		if statusRaw, ok := body["status"]; ok && statusRaw != nil {
-			if status, ok := statusRaw.(float64); ok {
-				switch status {
-				case zipDeployError:
-					return nil, "", fmt.Errorf("zip deployment failed")
-				case zipDeployComplete:
-					return status, "complete", nil
-				default:
-					return status, "pending", nil
-				}
-			}
+// deploymentLogExcerpt returns the most recent entries of the Kudu log for a deployment, this is best-effort since it's
+// only used to give context to a failure - as such any error retrieving the log results in an empty excerpt.
+func deploymentLogExcerpt(ctx context.Context, client *web.AppsClient, logUrl string) string {
+	if logUrl == "" {
+		return ""
+	}
+
+	entries := make([]deploymentLogEntry, 0)
+	if err := getKuduJSON(ctx, client, logUrl, &entries); err != nil {
+		return ""
+	}
+
+	if len(entries) > deploymentLogExcerptLength {
+		entries = entries[len(entries)-deploymentLogExcerptLength:]
+	}
+
+	lines := make([]string, 0, len(entries))
+	for _, v := range entries {
+		if v.Message != "" {
+			lines = append(lines, fmt.Sprintf("  %s", v.Message))
 		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func getKuduJSON(ctx context.Context, client *web.AppsClient, endpoint string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header["User-Agent"] = []string{client.UserAgent}
+
+	if req, err = autorest.Prepare(req, client.WithAuthorization()); err != nil {
+		return fmt.Errorf("authorizing request: %+v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
 
-		return nil, "", fmt.Errorf("could not determine status from deployment response")
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("unexpected status %s", resp.Status)
 	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response body: %+v", err)
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("parsing response body: %+v", err)
+	}
+
+	return nil
 }
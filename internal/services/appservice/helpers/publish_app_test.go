@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+)
+
+func TestPublishOneDeployPackagePollsStartedDeployment(t *testing.T) {
+	deploymentPollDelay = 0
+	deploymentPollInterval = 10 * time.Millisecond
+	defer func() {
+		deploymentPollDelay = 10 * time.Second
+		deploymentPollInterval = 10 * time.Second
+	}()
+
+	testData := []struct {
+		name          string
+		latestStatus  float64
+		startedStatus float64
+		expectError   bool
+	}{
+		{
+			name:          "started deployment succeeds while latest has failed",
+			latestStatus:  deploymentStatusFailed,
+			startedStatus: deploymentStatusSuccess,
+			expectError:   false,
+		},
+		{
+			name:          "started deployment fails while latest has succeeded",
+			latestStatus:  deploymentStatusSuccess,
+			startedStatus: deploymentStatusFailed,
+			expectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/publish", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/api/deployments/started")
+			w.WriteHeader(http.StatusAccepted)
+		})
+		mux.HandleFunc("/api/deployments/latest", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "previous", "status": v.latestStatus})
+		})
+		mux.HandleFunc("/api/deployments/started", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "started", "status": v.startedStatus})
+		})
+		server := httptest.NewServer(mux)
+
+		sourceFile := filepath.Join(t.TempDir(), "app.zip")
+		if err := os.WriteFile(sourceFile, []byte("package"), 0o600); err != nil {
+			t.Fatalf("writing package: %+v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		client := web.NewAppsClient("00000000-0000-0000-0000-000000000000")
+		err := publishOneDeployPackage(ctx, &client, server.URL, sourceFile)
+		cancel()
+		server.Close()
+
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), `"started"`) {
+				t.Fatalf("expected the error to refer to the started deployment but got: %+v", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestPublishOneDeployPackageRequiresLocation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sourceFile := filepath.Join(t.TempDir(), "app.zip")
+	if err := os.WriteFile(sourceFile, []byte("package"), 0o600); err != nil {
+		t.Fatalf("writing package: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client := web.NewAppsClient("00000000-0000-0000-0000-000000000000")
+	if err := publishOneDeployPackage(ctx, &client, server.URL, sourceFile); err == nil {
+		t.Fatalf("expected an error when the publish response has no `Location` but didn't get one")
+	}
+}
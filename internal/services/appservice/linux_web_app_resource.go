@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	webAuthV2Sdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -33,6 +34,7 @@ type LinuxWebAppModel struct {
 	AppSettings                   map[string]string          `tfschema:"app_settings"`
 	StickySettings                []helpers.StickySettings   `tfschema:"sticky_settings"`
 	AuthSettings                  []helpers.AuthSettings     `tfschema:"auth_settings"`
+	AuthV2Settings                []helpers.AuthV2Settings   `tfschema:"auth_settings_v2"`
 	Backup                        []helpers.Backup           `tfschema:"backup"`
 	ClientAffinityEnabled         bool                       `tfschema:"client_affinity_enabled"`
 	ClientCertEnabled             bool                       `tfschema:"client_certificate_enabled"`
@@ -46,6 +48,7 @@ type LinuxWebAppModel struct {
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
 	ZipDeployFile                 string                     `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                     `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string          `tfschema:"tags"`
 	CustomDomainVerificationId    string                     `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                     `tfschema:"default_hostname"`
@@ -59,6 +62,8 @@ type LinuxWebAppModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+
 var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
@@ -92,6 +97,8 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"auth_settings": helpers.AuthSettingsSchema(),
 
+		"auth_settings_v2": helpers.AuthV2SettingsSchema(),
+
 		"backup": helpers.BackupSchema(),
 
 		"client_affinity_enabled": {
@@ -159,7 +166,7 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the package to deploy to this Linux Web App. Files with a `.zip`, `.war`, `.jar` or `.ear` extension are deployed as packages of that type, any other file is deployed as a static file.",
 		},
 
 		"tags": tags.Schema(),
@@ -213,6 +220,12 @@ func (r LinuxWebAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the contents of the package deployed from `zip_deploy_file`.",
+		},
 	}
 }
 
@@ -368,6 +381,13 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webApp.AuthV2Settings) > 0 {
+				authV2 := helpers.ExpandAuthV2Settings(webApp.AuthV2Settings)
+				if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2); err != nil {
+					return fmt.Errorf("setting AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("logs") {
 				logsConfig := helpers.ExpandLogsConfig(webApp.LogsConfig)
 				if logsConfig.SiteLogsConfigProperties != nil {
@@ -401,9 +421,17 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 			}
 
 			if webApp.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile); err != nil {
+				if err = helpers.PublishAppPackage(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile); err != nil {
 					return err
 				}
+
+				zipDeployFileHash, err := helpers.PackageHash(webApp.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("calculating `zip_deploy_file_hash`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", zipDeployFileHash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
 			}
 
 			return nil
@@ -444,6 +472,14 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
 			}
 
+			var authV2 webAuthV2Sdk.SiteAuthSettingsV2
+			if helpers.UsesAuthV2(auth) {
+				authV2, err = metadata.Client.AppService.WebAppsAuthV2Client.GetAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil {
+					return fmt.Errorf("reading AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			backup, err := client.GetBackupConfiguration(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				if !utils.ResponseWasNotFound(backup.Response) {
@@ -526,6 +562,11 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.AuthSettings = helpers.FlattenAuthSettings(auth)
 
+			if helpers.UsesAuthV2(auth) {
+				state.AuthSettings = []helpers.AuthSettings{}
+				state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
+			}
+
 			state.Backup = helpers.FlattenBackupConfig(backup)
 
 			state.LogsConfig = helpers.FlattenLogsConfig(logsConfig)
@@ -543,6 +584,10 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			if deployFileHash, ok := metadata.ResourceData.Get("zip_deploy_file_hash").(string); ok {
+				state.ZipDeployFileHash = deployFileHash
+			}
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -736,6 +781,20 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				if len(state.AuthV2Settings) > 0 {
+					authV2Update := helpers.ExpandAuthV2Settings(state.AuthV2Settings)
+					if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2Update); err != nil {
+						return fmt.Errorf("updating AuthV2 Settings for Linux %s: %+v", id, err)
+					}
+				} else {
+					// removing `auth_settings_v2` reverts the app to the V1 Auth Settings with authentication disabled
+					if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, helpers.DisabledAuthV1Settings()); err != nil {
+						return fmt.Errorf("reverting to V1 Auth Settings for Linux %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, *authUpdate); err != nil {
@@ -773,10 +832,19 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") || metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
+			// the package is redeployed when its contents change, rather than when the path to it changes
+			if metadata.ResourceData.HasChange("zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				if err = helpers.PublishAppPackage(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
 					return err
 				}
+
+				zipDeployFileHash, err := helpers.PackageHash(state.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("calculating `zip_deploy_file_hash`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", zipDeployFileHash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
 			}
 
 			return nil
@@ -817,3 +885,12 @@ func (r LinuxWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 		return nil
 	}
 }
+
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashCustomizeDiff(metadata.ResourceDiff)
+		},
+	}
+}
@@ -450,6 +450,58 @@ func TestAccLinuxWebApp_withAuthSettingsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_withAuthSettingsV2ActiveDirectory(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withAuthSettingsV2ActiveDirectory(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLinuxWebApp_withAuthSettingsV2Update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withAuthSettingsV2ActiveDirectory(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withAuthSettingsV2Complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auth_settings_v2.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccLinuxWebApp_withStorageAccount(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
 	r := LinuxWebAppResource{}
@@ -1108,9 +1160,10 @@ func TestAccLinuxWebApp_zipDeploy(t *testing.T) {
 			Config: r.zipDeploy(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zip_deploy_file_hash").Exists(),
 			),
 		},
-		data.ImportStep("zip_deploy_file"),
+		data.ImportStep("zip_deploy_file", "zip_deploy_file_hash"),
 	})
 }
 
@@ -2214,6 +2267,138 @@ resource "azurerm_linux_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger, data.Client().TenantID)
 }
 
+func (r LinuxWebAppResource) withAuthSettingsV2ActiveDirectory(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  auth_settings_v2 {
+    auth_enabled           = true
+    require_authentication = true
+    default_provider       = "azureactivedirectory"
+    unauthenticated_action = "RedirectToLoginPage"
+
+    active_directory {
+      client_id                  = "aadclientid"
+      client_secret_setting_name = "MICROSOFT_PROVIDER_AUTHENTICATION_SECRET"
+      tenant_auth_endpoint       = "https://sts.windows.net/%s/v2.0"
+
+      allowed_audiences = [
+        "activedirectorytokenaudiences",
+      ]
+    }
+
+    login {
+      token_store_enabled = true
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger, data.Client().TenantID)
+}
+
+func (r LinuxWebAppResource) withAuthSettingsV2Complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  auth_settings_v2 {
+    auth_enabled                            = true
+    runtime_version                         = "~1"
+    require_authentication                  = true
+    unauthenticated_action                  = "Return401"
+    default_provider                        = "github"
+    excluded_paths                          = ["/health"]
+    require_https                           = true
+    http_route_api_prefix                   = "/.auth"
+    forward_proxy_convention                = "Custom"
+    forward_proxy_custom_host_header_name   = "X-Forwarded-Host"
+    forward_proxy_custom_scheme_header_name = "X-Forwarded-Proto"
+
+    active_directory {
+      client_id                       = "aadclientid"
+      client_secret_setting_name      = "MICROSOFT_PROVIDER_AUTHENTICATION_SECRET"
+      tenant_auth_endpoint            = "https://sts.windows.net/%s/v2.0"
+      www_authentication_disabled     = true
+      jwt_allowed_groups              = ["group1"]
+      jwt_allowed_client_applications = ["app1"]
+      allowed_audiences               = ["activedirectorytokenaudiences"]
+
+      login_parameters = {
+        test_key = "test_value"
+      }
+    }
+
+    custom_oidc {
+      name                          = "testcustom"
+      client_id                     = "testclientid"
+      client_secret_setting_name    = "TESTCUSTOM_PROVIDER_AUTHENTICATION_SECRET"
+      openid_configuration_endpoint = "https://oidc.testcustom.contoso.com/.well-known/openid-configuration"
+      scopes                        = ["openid", "profile"]
+    }
+
+    facebook {
+      app_id                  = "testappid"
+      app_secret_setting_name = "FACEBOOK_PROVIDER_AUTHENTICATION_SECRET"
+      login_scopes            = ["public_profile"]
+    }
+
+    github {
+      client_id                  = "testgithubclientid"
+      client_secret_setting_name = "GITHUB_PROVIDER_AUTHENTICATION_SECRET"
+    }
+
+    google {
+      client_id                  = "testgoogleclientid"
+      client_secret_setting_name = "GOOGLE_PROVIDER_AUTHENTICATION_SECRET"
+    }
+
+    microsoft {
+      client_id                  = "testmsaclientid"
+      client_secret_setting_name = "MICROSOFT_ACCOUNT_PROVIDER_AUTHENTICATION_SECRET"
+    }
+
+    twitter {
+      consumer_key                 = "testtwitterconsumerkey"
+      consumer_secret_setting_name = "TWITTER_PROVIDER_AUTHENTICATION_SECRET"
+    }
+
+    login {
+      logout_endpoint                   = "/logout"
+      token_store_enabled               = true
+      token_refresh_extension_time      = 24
+      preserve_url_fragments_for_logins = true
+      allowed_external_redirect_urls    = ["https://example.com"]
+      cookie_expiration_convention      = "IdentityProviderDerived"
+      validate_nonce                    = true
+      nonce_expiration_time             = "00:10:00"
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger, data.Client().TenantID)
+}
+
 func (r LinuxWebAppResource) withStorageAccount(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	webAuthV2Sdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -28,6 +29,7 @@ type LinuxWebAppSlotModel struct {
 	AppServiceId                  string                              `tfschema:"app_service_id"`
 	AppSettings                   map[string]string                   `tfschema:"app_settings"`
 	AuthSettings                  []helpers.AuthSettings              `tfschema:"auth_settings"`
+	AuthV2Settings                []helpers.AuthV2Settings            `tfschema:"auth_settings_v2"`
 	Backup                        []helpers.Backup                    `tfschema:"backup"`
 	ClientAffinityEnabled         bool                                `tfschema:"client_affinity_enabled"`
 	ClientCertEnabled             bool                                `tfschema:"client_certificate_enabled"`
@@ -41,6 +43,7 @@ type LinuxWebAppSlotModel struct {
 	StorageAccounts               []helpers.StorageAccount            `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString          `tfschema:"connection_string"`
 	ZipDeployFile                 string                              `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                              `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string                   `tfschema:"tags"`
 	CustomDomainVerificationId    string                              `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                              `tfschema:"default_hostname"`
@@ -55,6 +58,8 @@ type LinuxWebAppSlotModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppSlotResource{}
+
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
 }
@@ -94,6 +99,8 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"auth_settings": helpers.AuthSettingsSchema(),
 
+		"auth_settings_v2": helpers.AuthV2SettingsSchema(),
+
 		"backup": helpers.BackupSchema(),
 
 		"client_affinity_enabled": {
@@ -159,7 +166,7 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the package to deploy to this Linux Web App Slot. Files with a `.zip`, `.war`, `.jar` or `.ear` extension are deployed as packages of that type, any other file is deployed as a static file.",
 		},
 
 		"tags": tags.Schema(),
@@ -219,6 +226,12 @@ func (r LinuxWebAppSlotResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the contents of the package deployed from `zip_deploy_file`.",
+		},
 	}
 }
 
@@ -324,6 +337,13 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webAppSlot.AuthV2Settings) > 0 {
+				authV2 := helpers.ExpandAuthV2Settings(webAppSlot.AuthV2Settings)
+				if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, *authV2, id.SlotName); err != nil {
+					return fmt.Errorf("setting AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("logs") {
 				logsConfig := helpers.ExpandLogsConfig(webAppSlot.LogsConfig)
 				if logsConfig.SiteLogsConfigProperties != nil {
@@ -357,9 +377,17 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			if webAppSlot.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile); err != nil {
+				if err = helpers.PublishAppPackageSlot(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile, id.SlotName); err != nil {
 					return err
 				}
+
+				zipDeployFileHash, err := helpers.PackageHash(webAppSlot.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("calculating `zip_deploy_file_hash`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", zipDeployFileHash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
 			}
 
 			return nil
@@ -400,6 +428,14 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
 			}
 
+			var authV2 webAuthV2Sdk.SiteAuthSettingsV2
+			if helpers.UsesAuthV2(auth) {
+				authV2, err = metadata.Client.AppService.WebAppsAuthV2Client.GetAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+				if err != nil {
+					return fmt.Errorf("reading AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			backup, err := client.GetBackupConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
 			if err != nil {
 				if !utils.ResponseWasNotFound(backup.Response) {
@@ -474,6 +510,11 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 
 			state.AuthSettings = helpers.FlattenAuthSettings(auth)
 
+			if helpers.UsesAuthV2(auth) {
+				state.AuthSettings = []helpers.AuthSettings{}
+				state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
+			}
+
 			state.Backup = helpers.FlattenBackupConfig(backup)
 
 			state.LogsConfig = helpers.FlattenLogsConfig(logsConfig)
@@ -491,6 +532,10 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			if deployFileHash, ok := metadata.ResourceData.Get("zip_deploy_file_hash").(string); ok {
+				state.ZipDeployFileHash = deployFileHash
+			}
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -635,6 +680,20 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				if len(state.AuthV2Settings) > 0 {
+					authV2Update := helpers.ExpandAuthV2Settings(state.AuthV2Settings)
+					if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, *authV2Update, id.SlotName); err != nil {
+						return fmt.Errorf("updating AuthV2 Settings for Linux %s: %+v", id, err)
+					}
+				} else {
+					// removing `auth_settings_v2` reverts the app to the V1 Auth Settings with authentication disabled
+					if _, err := client.UpdateAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, helpers.DisabledAuthV1Settings(), id.SlotName); err != nil {
+						return fmt.Errorf("reverting to V1 Auth Settings for Linux %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, *authUpdate, id.SlotName); err != nil {
@@ -672,13 +731,31 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") || metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
+			// the package is redeployed when its contents change, rather than when the path to it changes
+			if metadata.ResourceData.HasChange("zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				if err = helpers.PublishAppPackageSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
 					return err
 				}
+
+				zipDeployFileHash, err := helpers.PackageHash(state.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("calculating `zip_deploy_file_hash`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", zipDeployFileHash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
 			}
 
 			return nil
 		},
 	}
 }
+
+func (r LinuxWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashCustomizeDiff(metadata.ResourceDiff)
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StaticWebAppId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	StaticSiteName string
+}
+
+func NewStaticWebAppID(subscriptionId, resourceGroup, staticSiteName string) StaticWebAppId {
+	return StaticWebAppId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		StaticSiteName: staticSiteName,
+	}
+}
+
+func (id StaticWebAppId) String() string {
+	segments := []string{
+		fmt.Sprintf("Static Site Name %q", id.StaticSiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Static Web App", segmentsStr)
+}
+
+func (id StaticWebAppId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/staticSites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StaticSiteName)
+}
+
+// StaticWebAppID parses a StaticWebApp ID into an StaticWebAppId struct
+func StaticWebAppID(input string) (*StaticWebAppId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StaticWebAppId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StaticSiteName, err = id.PopSegment("staticSites"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
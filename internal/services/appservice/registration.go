@@ -22,6 +22,7 @@ func (r Registration) Name() string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		AppServiceEnvironmentV3DataSource{},
 		AppServiceSourceControlTokenDataSource{},
 		LinuxFunctionAppDataSource{},
 		LinuxWebAppDataSource{},
@@ -33,6 +34,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		AppServiceEnvironmentV3Resource{},
 		AppServiceSourceControlTokenResource{},
 		FunctionAppActiveSlotResource{},
 		FunctionAppFunctionResource{},
@@ -44,6 +46,8 @@ func (r Registration) Resources() []sdk.Resource {
 		ServicePlanResource{},
 		SourceControlResource{},
 		SourceControlSlotResource{},
+		StaticWebAppCustomDomainResource{},
+		StaticWebAppResource{},
 		WebAppActiveSlotResource{},
 		WebAppHybridConnectionResource{},
 		WindowsFunctionAppResource{},
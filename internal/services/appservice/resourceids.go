@@ -8,3 +8,5 @@ package appservice
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AppServiceEnvironment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/hostingEnvironments/hostingEnvironment1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FunctionAppFunction -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/functions/function1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AppHybridConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/relay1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StaticWebApp -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/staticSites/staticSite1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StaticWebAppCustomDomain -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/staticSites/staticSite1/customDomains/customDomain1
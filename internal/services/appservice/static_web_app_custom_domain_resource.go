@@ -0,0 +1,244 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	staticWebAppCustomDomainValidationTypeCname = "cname-delegation"
+	staticWebAppCustomDomainValidationTypeTxt   = "dns-txt-token"
+)
+
+type StaticWebAppCustomDomainResource struct{}
+
+var _ sdk.Resource = StaticWebAppCustomDomainResource{}
+
+type StaticWebAppCustomDomainModel struct {
+	StaticWebAppId  string `tfschema:"static_web_app_id"`
+	DomainName      string `tfschema:"domain_name"`
+	ValidationType  string `tfschema:"validation_type"`
+	ValidationToken string `tfschema:"validation_token"`
+}
+
+func (r StaticWebAppCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"static_web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StaticWebAppID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"validation_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				staticWebAppCustomDomainValidationTypeCname,
+				staticWebAppCustomDomainValidationTypeTxt,
+			}, false),
+			// the validation method isn't returned by the API, so it's unknown after an import - rather than
+			// recreating the Custom Domain the configured value is accepted in that case
+			DiffSuppressFunc: func(_, old, _ string, d *pluginsdk.ResourceData) bool {
+				return old == "" && d.Id() != ""
+			},
+		},
+	}
+}
+
+func (r StaticWebAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"validation_token": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r StaticWebAppCustomDomainResource) ModelObject() interface{} {
+	return &StaticWebAppCustomDomainModel{}
+}
+
+func (r StaticWebAppCustomDomainResource) ResourceType() string {
+	return "azurerm_static_web_app_custom_domain"
+}
+
+func (r StaticWebAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StaticWebAppCustomDomainID
+}
+
+func (r StaticWebAppCustomDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			var customDomain StaticWebAppCustomDomainModel
+			if err := metadata.Decode(&customDomain); err != nil {
+				return err
+			}
+
+			staticWebAppId, err := parse.StaticWebAppID(customDomain.StaticWebAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStaticWebAppCustomDomainID(staticWebAppId.SubscriptionId, staticWebAppId.ResourceGroup, staticWebAppId.StaticSiteName, customDomain.DomainName)
+
+			existing, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envelope := web.StaticSiteCustomDomainRequestPropertiesARMResource{
+				StaticSiteCustomDomainRequestPropertiesARMResourceProperties: &web.StaticSiteCustomDomainRequestPropertiesARMResourceProperties{
+					ValidationMethod: utils.String(customDomain.ValidationType),
+				},
+			}
+
+			future, err := client.CreateOrUpdateStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName, envelope)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			switch customDomain.ValidationType {
+			case staticWebAppCustomDomainValidationTypeCname:
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+				}
+
+			case staticWebAppCustomDomainValidationTypeTxt:
+				// the operation only completes once the TXT record exists, which requires the validation token - so
+				// rather than waiting on the future we wait for the token to become available
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("context had no deadline")
+				}
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending: []string{
+						string(web.CustomDomainStatusAdding),
+						string(web.CustomDomainStatusRetrievingValidationToken),
+					},
+					Target: []string{
+						string(web.CustomDomainStatusValidating),
+						string(web.CustomDomainStatusReady),
+					},
+					MinTimeout: 20 * time.Second,
+					Timeout:    time.Until(deadline),
+					Refresh:    staticWebAppCustomDomainStatusRefreshFunc(ctx, client, id),
+				}
+
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for the validation token of %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r StaticWebAppCustomDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			id, err := parse.StaticWebAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			customDomain, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+			if err != nil {
+				if utils.ResponseWasNotFound(customDomain.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := StaticWebAppCustomDomainModel{
+				StaticWebAppId: parse.NewStaticWebAppID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID(),
+				DomainName:     id.CustomDomainName,
+				// the validation method isn't returned by the API
+				ValidationType: metadata.ResourceData.Get("validation_type").(string),
+			}
+
+			// the API stops returning the validation token once the Custom Domain has been validated, at which
+			// point the existing value is retained so that it can still be referenced
+			state.ValidationToken = metadata.ResourceData.Get("validation_token").(string)
+			if props := customDomain.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil && props.ValidationToken != nil && *props.ValidationToken != "" {
+				state.ValidationToken = *props.ValidationToken
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r StaticWebAppCustomDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			id, err := parse.StaticWebAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", id)
+
+			future, err := client.DeleteStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func staticWebAppCustomDomainStatusRefreshFunc(ctx context.Context, client *web.StaticSitesClient, id parse.StaticWebAppCustomDomainId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		customDomain, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		props := customDomain.StaticSiteCustomDomainOverviewARMResourceProperties
+		if props == nil {
+			return nil, "", fmt.Errorf("`properties` was missing from the response")
+		}
+
+		if props.Status == web.CustomDomainStatusFailed {
+			return nil, "", fmt.Errorf("custom domain failed: %s", utils.NormalizeNilableString(props.ErrorMessage))
+		}
+
+		return customDomain, string(props.Status), nil
+	}
+}
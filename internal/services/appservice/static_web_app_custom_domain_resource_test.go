@@ -0,0 +1,101 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticWebAppCustomDomainResource struct{}
+
+func TestAccStaticWebAppCustomDomain_txtValidation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_custom_domain", "test")
+	r := StaticWebAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txtValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").Exists(),
+			),
+		},
+		data.ImportStep("validation_type", "validation_token"),
+	})
+}
+
+func TestAccStaticWebAppCustomDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_custom_domain", "test")
+	r := StaticWebAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txtValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticWebAppCustomDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StaticWebAppCustomDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.StaticSitesClient.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StaticWebAppCustomDomainResource) txtValidation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_web_app" "test" {
+  name                = "acctestSWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_static_web_app_custom_domain" "test" {
+  static_web_app_id = azurerm_static_web_app.test.id
+  domain_name       = "acctestSWA-%[1]d.contoso.com"
+  validation_type   = "dns-txt-token"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StaticWebAppCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_web_app_custom_domain" "import" {
+  static_web_app_id = azurerm_static_web_app_custom_domain.test.static_web_app_id
+  domain_name       = azurerm_static_web_app_custom_domain.test.domain_name
+  validation_type   = azurerm_static_web_app_custom_domain.test.validation_type
+}
+`, r.txtValidation(data))
+}
@@ -0,0 +1,362 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticWebAppResource struct{}
+
+var _ sdk.ResourceWithUpdate = StaticWebAppResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = StaticWebAppResource{}
+
+type StaticWebAppModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	SkuTier           string            `tfschema:"sku_tier"`
+	SkuSize           string            `tfschema:"sku_size"`
+	AppSettings       map[string]string `tfschema:"app_settings"`
+	Tags              map[string]string `tfschema:"tags"`
+	ApiKey            string            `tfschema:"api_key"`
+	DefaultHostName   string            `tfschema:"default_host_name"`
+}
+
+func (r StaticWebAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StaticWebAppName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_tier": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(web.SkuNameFree),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(web.SkuNameStandard),
+				string(web.SkuNameFree),
+			}, false),
+		},
+
+		"sku_size": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(web.SkuNameFree),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(web.SkuNameStandard),
+				string(web.SkuNameFree),
+			}, false),
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r StaticWebAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_host_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StaticWebAppResource) ModelObject() interface{} {
+	return &StaticWebAppModel{}
+}
+
+func (r StaticWebAppResource) ResourceType() string {
+	return "azurerm_static_web_app"
+}
+
+func (r StaticWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StaticWebAppID
+}
+
+func (r StaticWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// See: https://github.com/Azure/azure-rest-api-specs/issues/17525
+			if rd.Get("sku_size").(string) == string(web.SkuNameFree) && len(rd.Get("identity").([]interface{})) > 0 {
+				return fmt.Errorf("a Managed Identity cannot be used when `sku_size` is set to `Free`")
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r StaticWebAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var staticWebApp StaticWebAppModel
+			if err := metadata.Decode(&staticWebApp); err != nil {
+				return err
+			}
+
+			id := parse.NewStaticWebAppID(subscriptionId, staticWebApp.ResourceGroupName, staticWebApp.Name)
+
+			existing, err := client.GetStaticSite(ctx, id.ResourceGroup, id.StaticSiteName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envelope := web.StaticSiteARMResource{
+				Location: utils.String(location.Normalize(staticWebApp.Location)),
+				Sku: &web.SkuDescription{
+					Name: utils.String(staticWebApp.SkuSize),
+					Tier: utils.String(staticWebApp.SkuTier),
+				},
+				StaticSite: &web.StaticSite{},
+				Tags:       tags.FromTypedObject(staticWebApp.Tags),
+			}
+
+			if v := metadata.ResourceData.Get("identity").([]interface{}); len(v) > 0 {
+				expandedIdentity, err := expandIdentity(v)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				envelope.Identity = expandedIdentity
+			}
+
+			future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, envelope)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			if len(staticWebApp.AppSettings) > 0 {
+				appSettings := web.StringDictionary{
+					Properties: expandStaticWebAppAppSettings(staticWebApp.AppSettings),
+				}
+				if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.StaticSiteName, appSettings); err != nil {
+					return fmt.Errorf("setting `app_settings` for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r StaticWebAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			id, err := parse.StaticWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			staticWebApp, err := client.GetStaticSite(ctx, id.ResourceGroup, id.StaticSiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(staticWebApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := StaticWebAppModel{
+				Name:              id.StaticSiteName,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(staticWebApp.Location),
+				Tags:              tags.ToTypedObject(staticWebApp.Tags),
+			}
+
+			if sku := staticWebApp.Sku; sku != nil {
+				state.SkuSize = utils.NormalizeNilableString(sku.Name)
+				state.SkuTier = utils.NormalizeNilableString(sku.Tier)
+			}
+
+			if props := staticWebApp.StaticSite; props != nil {
+				state.DefaultHostName = utils.NormalizeNilableString(props.DefaultHostname)
+			}
+
+			appSettings, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.StaticSiteName)
+			if err != nil {
+				return fmt.Errorf("listing app settings for %s: %+v", id, err)
+			}
+			state.AppSettings = flattenStaticWebAppAppSettings(appSettings.Properties)
+
+			secrets, err := client.ListStaticSiteSecrets(ctx, id.ResourceGroup, id.StaticSiteName)
+			if err != nil {
+				return fmt.Errorf("listing secrets for %s: %+v", id, err)
+			}
+			if apiKey := secrets.Properties["apiKey"]; apiKey != nil {
+				state.ApiKey = *apiKey
+			}
+
+			flattenedIdentity, err := flattenIdentity(staticWebApp.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r StaticWebAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			id, err := parse.StaticWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StaticWebAppModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChanges("sku_tier", "sku_size", "identity", "tags") {
+				existing, err := client.GetStaticSite(ctx, id.ResourceGroup, id.StaticSiteName)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				if metadata.ResourceData.HasChanges("sku_tier", "sku_size") {
+					existing.Sku = &web.SkuDescription{
+						Name: utils.String(state.SkuSize),
+						Tier: utils.String(state.SkuTier),
+					}
+				}
+
+				if metadata.ResourceData.HasChange("identity") {
+					expandedIdentity, err := expandIdentity(metadata.ResourceData.Get("identity").([]interface{}))
+					if err != nil {
+						return fmt.Errorf("expanding `identity`: %+v", err)
+					}
+					existing.Identity = expandedIdentity
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					existing.Tags = tags.FromTypedObject(state.Tags)
+				}
+
+				future, err := client.CreateOrUpdateStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, existing)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("app_settings") {
+				// the API replaces the complete set of App Settings, so sending an empty map removes any existing ones
+				appSettings := web.StringDictionary{
+					Properties: expandStaticWebAppAppSettings(state.AppSettings),
+				}
+				if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.StaticSiteName, appSettings); err != nil {
+					return fmt.Errorf("updating `app_settings` for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r StaticWebAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+			id, err := parse.StaticWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", id)
+
+			future, err := client.DeleteStaticSite(ctx, id.ResourceGroup, id.StaticSiteName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandStaticWebAppAppSettings(input map[string]string) map[string]*string {
+	output := make(map[string]*string, len(input))
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenStaticWebAppAppSettings(input map[string]*string) map[string]string {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+
+	return output
+}
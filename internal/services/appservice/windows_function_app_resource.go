@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	webAuthV2Sdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -42,6 +43,7 @@ type WindowsFunctionAppModel struct {
 	AppSettings                 map[string]string                      `tfschema:"app_settings"`
 	StickySettings              []helpers.StickySettings               `tfschema:"sticky_settings"`
 	AuthSettings                []helpers.AuthSettings                 `tfschema:"auth_settings"`
+	AuthV2Settings              []helpers.AuthV2Settings               `tfschema:"auth_settings_v2"`
 	Backup                      []helpers.Backup                       `tfschema:"backup"` // Not supported on Dynamic or Basic plans
 	BuiltinLogging              bool                                   `tfschema:"builtin_logging_enabled"`
 	ClientCertEnabled           bool                                   `tfschema:"client_certificate_enabled"`
@@ -164,6 +166,8 @@ func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"auth_settings": helpers.AuthSettingsSchema(),
 
+		"auth_settings_v2": helpers.AuthV2SettingsSchema(),
+
 		"backup": helpers.BackupSchema(),
 
 		"builtin_logging_enabled": {
@@ -499,6 +503,13 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(functionApp.AuthV2Settings) > 0 {
+				authV2 := helpers.ExpandAuthV2Settings(functionApp.AuthV2Settings)
+				if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2); err != nil {
+					return fmt.Errorf("setting AuthV2 Settings for Windows %s: %+v", id, err)
+				}
+			}
+
 			connectionStrings := helpers.ExpandConnectionStrings(functionApp.ConnectionStrings)
 			if connectionStrings.Properties != nil {
 				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, *connectionStrings); err != nil {
@@ -574,6 +585,14 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Auth Settings for Windows %s: %+v", id, err)
 			}
 
+			var authV2 webAuthV2Sdk.SiteAuthSettingsV2
+			if helpers.UsesAuthV2(auth) {
+				authV2, err = metadata.Client.AppService.WebAppsAuthV2Client.GetAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil {
+					return fmt.Errorf("reading AuthV2 Settings for Windows %s: %+v", id, err)
+				}
+			}
+
 			backup, err := client.GetBackupConfiguration(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				if !utils.ResponseWasNotFound(backup.Response) {
@@ -631,6 +650,11 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 
 			state.AuthSettings = helpers.FlattenAuthSettings(auth)
 
+			if helpers.UsesAuthV2(auth) {
+				state.AuthSettings = []helpers.AuthSettings{}
+				state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
+			}
+
 			state.Backup = helpers.FlattenBackupConfig(backup)
 
 			state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs)
@@ -853,6 +877,20 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				if len(state.AuthV2Settings) > 0 {
+					authV2Update := helpers.ExpandAuthV2Settings(state.AuthV2Settings)
+					if _, err := metadata.Client.AppService.WebAppsAuthV2Client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2Update); err != nil {
+						return fmt.Errorf("updating AuthV2 Settings for Windows %s: %+v", id, err)
+					}
+				} else {
+					// removing `auth_settings_v2` reverts the app to the V1 Auth Settings with authentication disabled
+					if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, helpers.DisabledAuthV1Settings()); err != nil {
+						return fmt.Errorf("reverting to V1 Auth Settings for Windows %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, *authUpdate); err != nil {
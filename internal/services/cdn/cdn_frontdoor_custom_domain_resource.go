@@ -0,0 +1,341 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorCustomDomainCreate,
+		Read:   resourceCdnFrontDoorCustomDomainRead,
+		Update: resourceCdnFrontDoorCustomDomainUpdate,
+		Delete: resourceCdnFrontDoorCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(12 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnFrontDoorCustomDomainName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the DNS Zone can't be removed from a Custom Domain by an update, so changing it requires the Custom Domain to be recreated
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+			},
+
+			"tls": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdCertificateTypeCustomerCertificate),
+								string(cdn.AfdCertificateTypeManagedCertificate),
+							}, false),
+						},
+
+						"minimum_tls_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdMinimumTLSVersionTLS10),
+								string(cdn.AfdMinimumTLSVersionTLS12),
+							}, false),
+						},
+
+						"cdn_frontdoor_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.FrontDoorSecretID,
+						},
+					},
+				},
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_token": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_custom_domain", id.ID())
+	}
+
+	tls, err := expandCdnFrontDoorCustomDomainTLSParameters(d.Get("tls").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDDomain{
+		AFDDomainProperties: &cdn.AFDDomainProperties{
+			HostName:    utils.String(d.Get("host_name").(string)),
+			TLSSettings: tls,
+		},
+	}
+
+	if v := d.Get("dns_zone_id").(string); v != "" {
+		props.AFDDomainProperties.AzureDNSZone = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.AFDDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		dnsZoneId := ""
+		if props.AzureDNSZone != nil && props.AzureDNSZone.ID != nil {
+			parsed, err := zones.ParseDnsZoneIDInsensitively(*props.AzureDNSZone.ID)
+			if err != nil {
+				return err
+			}
+			dnsZoneId = parsed.ID()
+		}
+		d.Set("dns_zone_id", dnsZoneId)
+
+		tls, err := flattenCdnFrontDoorCustomDomainTLSParameters(props.TLSSettings)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("tls", tls); err != nil {
+			return fmt.Errorf("setting 'tls': %+v", err)
+		}
+
+		expirationDate := ""
+		validationToken := ""
+		if validationProps := props.ValidationProperties; validationProps != nil {
+			expirationDate = utils.NormalizeNilableString(validationProps.ExpirationDate)
+			validationToken = utils.NormalizeNilableString(validationProps.ValidationToken)
+		}
+		d.Set("expiration_date", expirationDate)
+		d.Set("validation_token", validationToken)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := cdn.AFDDomainUpdatePropertiesParameters{}
+
+	if d.HasChange("tls") {
+		tls, err := expandCdnFrontDoorCustomDomainTLSParameters(d.Get("tls").([]interface{}))
+		if err != nil {
+			return err
+		}
+		params.TLSSettings = tls
+	}
+
+	payload := cdn.AFDDomainUpdateParameters{
+		AFDDomainUpdatePropertiesParameters: &params,
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, payload)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorCustomDomainTLSParameters(input []interface{}) (*cdn.AFDDomainHTTPSParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	certificateType := cdn.AfdCertificateType(v["certificate_type"].(string))
+	secretId := v["cdn_frontdoor_secret_id"].(string)
+
+	// a Managed Certificate is issued by Front Door and can't be combined with a Secret - whereas a Customer Certificate
+	// requires the Secret containing the Key Vault Certificate to be specified
+	if certificateType == cdn.AfdCertificateTypeCustomerCertificate && secretId == "" {
+		return nil, fmt.Errorf("the 'cdn_frontdoor_secret_id' field must be set when the 'certificate_type' is %q", certificateType)
+	}
+
+	if certificateType == cdn.AfdCertificateTypeManagedCertificate && secretId != "" {
+		return nil, fmt.Errorf("the 'cdn_frontdoor_secret_id' field cannot be set when the 'certificate_type' is %q", certificateType)
+	}
+
+	output := &cdn.AFDDomainHTTPSParameters{
+		CertificateType:   certificateType,
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(v["minimum_tls_version"].(string)),
+	}
+
+	if secretId != "" {
+		output.Secret = &cdn.ResourceReference{
+			ID: utils.String(secretId),
+		}
+	}
+
+	return output, nil
+}
+
+func flattenCdnFrontDoorCustomDomainTLSParameters(input *cdn.AFDDomainHTTPSParameters) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	// Front Door also returns the Secret it generates for a Managed Certificate, which isn't user configurable
+	secretId := ""
+	if input.CertificateType == cdn.AfdCertificateTypeCustomerCertificate && input.Secret != nil && input.Secret.ID != nil {
+		parsed, err := parse.FrontDoorSecretIDInsensitively(*input.Secret.ID)
+		if err != nil {
+			return nil, err
+		}
+		secretId = parsed.ID()
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_type":        string(input.CertificateType),
+			"minimum_tls_version":     string(input.MinimumTLSVersion),
+			"cdn_frontdoor_secret_id": secretId,
+		},
+	}, nil
+}
@@ -0,0 +1,674 @@
+package cdn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRouteCreate,
+		Read:   resourceCdnFrontDoorRouteRead,
+		Update: resourceCdnFrontDoorRouteUpdate,
+		Delete: resourceCdnFrontDoorRouteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRouteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnFrontDoorRouteName,
+			},
+
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			// the API rejects a Route whose Origin Group doesn't contain any Origins, this field
+			// isn't sent to the API but ensures the Origins are provisioned before the Route
+			"cdn_frontdoor_origin_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorOriginID,
+				},
+			},
+
+			"patterns_to_match": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 25,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"supported_protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.AFDEndpointProtocolsHTTP),
+						string(cdn.AFDEndpointProtocolsHTTPS),
+					}, false),
+				},
+			},
+
+			"forwarding_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.ForwardingProtocolMatchRequest),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.ForwardingProtocolHTTPOnly),
+					string(cdn.ForwardingProtocolHTTPSOnly),
+					string(cdn.ForwardingProtocolMatchRequest),
+				}, false),
+			},
+
+			"cache": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query_string_caching_behavior": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreSpecifiedQueryStrings),
+								string(cdn.AfdQueryStringCachingBehaviorIncludeSpecifiedQueryStrings),
+								string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+							}, false),
+						},
+
+						"query_strings": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"compression_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"content_types_to_compress": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_custom_domain_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorCustomDomainID,
+				},
+			},
+
+			"cdn_frontdoor_origin_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_rule_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorRuleSetID,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_redirect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"link_to_default_domain": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_route", id.ID())
+	}
+
+	protocols := expandCdnFrontDoorRouteEndpointProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List())
+	httpsRedirect := d.Get("https_redirect_enabled").(bool)
+	linkToDefaultDomain := d.Get("link_to_default_domain").(bool)
+
+	customDomains, err := expandCdnFrontDoorRouteCustomDomains(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{}), id.ProfileName)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCdnFrontDoorRoute(protocols, httpsRedirect, linkToDefaultDomain, customDomains); err != nil {
+		return err
+	}
+
+	props := cdn.Route{
+		RouteProperties: expandCdnFrontDoorRouteProperties(d, protocols, customDomains),
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteName)
+	d.Set("cdn_frontdoor_endpoint_id", parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID())
+
+	if props := resp.RouteProperties; props != nil {
+		customDomains, err := flattenCdnFrontDoorRouteCustomDomains(props.CustomDomains)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("cdn_frontdoor_custom_domain_ids", customDomains); err != nil {
+			return fmt.Errorf("setting 'cdn_frontdoor_custom_domain_ids': %+v", err)
+		}
+
+		originGroupId := ""
+		if props.OriginGroup != nil && props.OriginGroup.ID != nil {
+			parsed, err := parse.FrontDoorOriginGroupIDInsensitively(*props.OriginGroup.ID)
+			if err != nil {
+				return err
+			}
+			originGroupId = parsed.ID()
+		}
+		d.Set("cdn_frontdoor_origin_group_id", originGroupId)
+
+		// `cdn_frontdoor_origin_ids` isn't returned by the API, so the value from the config/state is retained and is
+		// therefore empty after an import
+
+		ruleSets, err := flattenCdnFrontDoorRouteRuleSets(props.RuleSets)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("cdn_frontdoor_rule_set_ids", ruleSets); err != nil {
+			return fmt.Errorf("setting 'cdn_frontdoor_rule_set_ids': %+v", err)
+		}
+
+		if err := d.Set("cache", flattenCdnFrontDoorRouteCacheConfiguration(props.CacheConfiguration)); err != nil {
+			return fmt.Errorf("setting 'cache': %+v", err)
+		}
+
+		if err := d.Set("patterns_to_match", utils.FlattenStringSlice(props.PatternsToMatch)); err != nil {
+			return fmt.Errorf("setting 'patterns_to_match': %+v", err)
+		}
+
+		if err := d.Set("supported_protocols", flattenCdnFrontDoorRouteEndpointProtocols(props.SupportedProtocols)); err != nil {
+			return fmt.Errorf("setting 'supported_protocols': %+v", err)
+		}
+
+		d.Set("cdn_frontdoor_origin_path", props.OriginPath)
+		d.Set("enabled", flattenEnabledBool(props.EnabledState))
+		d.Set("forwarding_protocol", string(props.ForwardingProtocol))
+		d.Set("https_redirect_enabled", props.HTTPSRedirect == cdn.HTTPSRedirectEnabled)
+		d.Set("link_to_default_domain", props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRouteUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protocols := expandCdnFrontDoorRouteEndpointProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List())
+	httpsRedirect := d.Get("https_redirect_enabled").(bool)
+	linkToDefaultDomain := d.Get("link_to_default_domain").(bool)
+
+	customDomains, err := expandCdnFrontDoorRouteCustomDomains(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{}), id.ProfileName)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCdnFrontDoorRoute(protocols, httpsRedirect, linkToDefaultDomain, customDomains); err != nil {
+		return err
+	}
+
+	// caching is disabled by omitting the cache configuration, which a PATCH would leave untouched - so in
+	// that case the whole Route needs to be sent instead
+	if d.HasChange("cache") && len(d.Get("cache").([]interface{})) == 0 {
+		route := cdn.Route{
+			RouteProperties: expandCdnFrontDoorRouteProperties(d, protocols, customDomains),
+		}
+
+		future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, route)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+		}
+
+		return resourceCdnFrontDoorRouteRead(d, meta)
+	}
+
+	params := cdn.RouteUpdatePropertiesParameters{}
+
+	if d.HasChange("cache") {
+		params.CacheConfiguration = expandCdnFrontDoorRouteCacheConfiguration(d.Get("cache").([]interface{}))
+	}
+
+	if d.HasChange("cdn_frontdoor_custom_domain_ids") {
+		params.CustomDomains = customDomains
+	}
+
+	if d.HasChange("cdn_frontdoor_origin_group_id") {
+		params.OriginGroup = &cdn.ResourceReference{
+			ID: utils.String(d.Get("cdn_frontdoor_origin_group_id").(string)),
+		}
+	}
+
+	if d.HasChange("cdn_frontdoor_origin_path") {
+		params.OriginPath = utils.String(d.Get("cdn_frontdoor_origin_path").(string))
+	}
+
+	if d.HasChange("cdn_frontdoor_rule_set_ids") {
+		params.RuleSets = expandCdnFrontDoorRouteResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").([]interface{}))
+	}
+
+	if d.HasChange("enabled") {
+		params.EnabledState = expandEnabledBool(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("forwarding_protocol") {
+		params.ForwardingProtocol = cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string))
+	}
+
+	if d.HasChange("https_redirect_enabled") {
+		params.HTTPSRedirect = expandCdnFrontDoorRouteHttpsRedirect(httpsRedirect)
+	}
+
+	if d.HasChange("link_to_default_domain") {
+		params.LinkToDefaultDomain = expandCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain)
+	}
+
+	if d.HasChange("patterns_to_match") {
+		params.PatternsToMatch = utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{}))
+	}
+
+	if d.HasChange("supported_protocols") {
+		params.SupportedProtocols = protocols
+	}
+
+	payload := cdn.RouteUpdateParameters{
+		RouteUpdatePropertiesParameters: &params,
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, payload)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorRouteProperties(d *pluginsdk.ResourceData, protocols *[]cdn.AFDEndpointProtocols, customDomains *[]cdn.ActivatedResourceReference) *cdn.RouteProperties {
+	props := &cdn.RouteProperties{
+		CacheConfiguration:  expandCdnFrontDoorRouteCacheConfiguration(d.Get("cache").([]interface{})),
+		CustomDomains:       customDomains,
+		EnabledState:        expandEnabledBool(d.Get("enabled").(bool)),
+		ForwardingProtocol:  cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+		HTTPSRedirect:       expandCdnFrontDoorRouteHttpsRedirect(d.Get("https_redirect_enabled").(bool)),
+		LinkToDefaultDomain: expandCdnFrontDoorRouteLinkToDefaultDomain(d.Get("link_to_default_domain").(bool)),
+		OriginGroup: &cdn.ResourceReference{
+			ID: utils.String(d.Get("cdn_frontdoor_origin_group_id").(string)),
+		},
+		PatternsToMatch:    utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+		RuleSets:           expandCdnFrontDoorRouteResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").([]interface{})),
+		SupportedProtocols: protocols,
+	}
+
+	if originPath := d.Get("cdn_frontdoor_origin_path").(string); originPath != "" {
+		props.OriginPath = utils.String(originPath)
+	}
+
+	return props
+}
+
+// validateCdnFrontDoorRoute checks the combinations of fields which the API rejects
+func validateCdnFrontDoorRoute(protocols *[]cdn.AFDEndpointProtocols, httpsRedirect bool, linkToDefaultDomain bool, customDomains *[]cdn.ActivatedResourceReference) error {
+	if httpsRedirect {
+		hasHttp := false
+		hasHttps := false
+		for _, v := range *protocols {
+			switch v {
+			case cdn.AFDEndpointProtocolsHTTP:
+				hasHttp = true
+			case cdn.AFDEndpointProtocolsHTTPS:
+				hasHttps = true
+			}
+		}
+
+		if !hasHttp || !hasHttps {
+			return fmt.Errorf("the 'https_redirect_enabled' field can only be set to 'true' when the 'supported_protocols' field contains both the %q and %q protocols", cdn.AFDEndpointProtocolsHTTP, cdn.AFDEndpointProtocolsHTTPS)
+		}
+	}
+
+	// a Route must be associated with at least one domain
+	if !linkToDefaultDomain && len(*customDomains) == 0 {
+		return fmt.Errorf("the 'link_to_default_domain' field can only be set to 'false' when at least one 'cdn_frontdoor_custom_domain_ids' has been specified")
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorRouteCustomDomains(input []interface{}, profileName string) (*[]cdn.ActivatedResourceReference, error) {
+	output := make([]cdn.ActivatedResourceReference, 0)
+	seen := make(map[string]struct{})
+
+	for _, v := range input {
+		customDomainId, err := parse.FrontDoorCustomDomainID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		// the Custom Domain has to belong to the same Front Door Profile as the Route's Endpoint
+		if customDomainId.ProfileName != profileName {
+			return nil, fmt.Errorf("the Front Door Custom Domain %q must belong to the Front Door Profile %q, got %q", customDomainId.CustomDomainName, profileName, customDomainId.ProfileName)
+		}
+
+		key := strings.ToLower(customDomainId.ID())
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("the Front Door Custom Domain %q is referenced more than once in 'cdn_frontdoor_custom_domain_ids'", customDomainId.CustomDomainName)
+		}
+		seen[key] = struct{}{}
+
+		output = append(output, cdn.ActivatedResourceReference{
+			ID: utils.String(customDomainId.ID()),
+		})
+	}
+
+	return &output, nil
+}
+
+func flattenCdnFrontDoorRouteCustomDomains(input *[]cdn.ActivatedResourceReference) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		customDomainId, err := parse.FrontDoorCustomDomainIDInsensitively(*v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, customDomainId.ID())
+	}
+
+	return output, nil
+}
+
+func flattenCdnFrontDoorRouteRuleSets(input *[]cdn.ResourceReference) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		ruleSetId, err := parse.FrontDoorRuleSetIDInsensitively(*v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, ruleSetId.ID())
+	}
+
+	return output, nil
+}
+
+func expandCdnFrontDoorRouteResourceReferences(input []interface{}) *[]cdn.ResourceReference {
+	output := make([]cdn.ResourceReference, 0)
+
+	for _, v := range input {
+		output = append(output, cdn.ResourceReference{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return &output
+}
+
+func expandCdnFrontDoorRouteEndpointProtocols(input []interface{}) *[]cdn.AFDEndpointProtocols {
+	output := make([]cdn.AFDEndpointProtocols, 0)
+
+	for _, v := range input {
+		output = append(output, cdn.AFDEndpointProtocols(v.(string)))
+	}
+
+	return &output
+}
+
+func flattenCdnFrontDoorRouteEndpointProtocols(input *[]cdn.AFDEndpointProtocols) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func expandCdnFrontDoorRouteHttpsRedirect(input bool) cdn.HTTPSRedirect {
+	if input {
+		return cdn.HTTPSRedirectEnabled
+	}
+
+	return cdn.HTTPSRedirectDisabled
+}
+
+func expandCdnFrontDoorRouteLinkToDefaultDomain(input bool) cdn.LinkToDefaultDomain {
+	if input {
+		return cdn.LinkToDefaultDomainEnabled
+	}
+
+	return cdn.LinkToDefaultDomainDisabled
+}
+
+func expandCdnFrontDoorRouteCacheConfiguration(input []interface{}) *cdn.AfdRouteCacheConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	output := &cdn.AfdRouteCacheConfiguration{
+		QueryStringCachingBehavior: cdn.AfdQueryStringCachingBehavior(v["query_string_caching_behavior"].(string)),
+		CompressionSettings: &cdn.CompressionSettings{
+			IsCompressionEnabled:   utils.Bool(v["compression_enabled"].(bool)),
+			ContentTypesToCompress: utils.ExpandStringSlice(v["content_types_to_compress"].([]interface{})),
+		},
+	}
+
+	// the query strings are sent to the API as a comma separated list
+	if queryStrings := *utils.ExpandStringSlice(v["query_strings"].([]interface{})); len(queryStrings) > 0 {
+		output.QueryParameters = utils.String(strings.Join(queryStrings, ","))
+	}
+
+	return output
+}
+
+func flattenCdnFrontDoorRouteCacheConfiguration(input *cdn.AfdRouteCacheConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	queryStrings := make([]interface{}, 0)
+	if input.QueryParameters != nil && *input.QueryParameters != "" {
+		for _, v := range strings.Split(*input.QueryParameters, ",") {
+			queryStrings = append(queryStrings, v)
+		}
+	}
+
+	compressionEnabled := false
+	contentTypesToCompress := make([]interface{}, 0)
+	if settings := input.CompressionSettings; settings != nil {
+		compressionEnabled = utils.NormaliseNilableBool(settings.IsCompressionEnabled)
+		contentTypesToCompress = utils.FlattenStringSlice(settings.ContentTypesToCompress)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"query_string_caching_behavior": string(input.QueryStringCachingBehavior),
+			"query_strings":                 queryStrings,
+			"compression_enabled":           compressionEnabled,
+			"content_types_to_compress":     contentTypesToCompress,
+		},
+	}
+}
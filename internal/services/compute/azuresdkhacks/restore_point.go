@@ -0,0 +1,61 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: this workaround exists since `consistencyMode` is read-only in API version 2021-11-01, it can only be specified
+// when creating a Restore Point from API version 2022-03-01 onwards
+
+// RestorePoint is the payload used to create a Restore Point with a specific consistency mode.
+type RestorePoint struct {
+	Properties RestorePointProperties `json:"properties"`
+}
+
+type RestorePointProperties struct {
+	ExcludeDisks    *[]compute.APIEntityReference `json:"excludeDisks,omitempty"`
+	ConsistencyMode compute.ConsistencyModeTypes  `json:"consistencyMode,omitempty"`
+}
+
+func CreateRestorePoint(ctx context.Context, client *compute.RestorePointsClient, resourceGroupName string, restorePointCollectionName string, restorePointName string, parameters RestorePoint) (result compute.RestorePointsCreateFuture, err error) {
+	req, err := restorePointCreatePreparer(ctx, client, resourceGroupName, restorePointCollectionName, restorePointName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.RestorePointsClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.RestorePointsClient", "Create", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func restorePointCreatePreparer(ctx context.Context, client *compute.RestorePointsClient, resourceGroupName string, restorePointCollectionName string, restorePointName string, parameters RestorePoint) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName":          autorest.Encode("path", resourceGroupName),
+		"restorePointCollectionName": autorest.Encode("path", restorePointCollectionName),
+		"restorePointName":           autorest.Encode("path", restorePointName),
+		"subscriptionId":             autorest.Encode("path", client.SubscriptionID),
+	}
+
+	const APIVersion = "2022-03-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/restorePointCollections/{restorePointCollectionName}/restorePoints/{restorePointName}", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -206,6 +206,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(true),
+
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 			"license_type": {
@@ -576,6 +578,12 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 		params.VirtualMachineProperties.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.VirtualMachineProperties.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(v.([]interface{})),
+		}
+	}
+
 	if !provisionVMAgent && allowExtensionOperations {
 		return fmt.Errorf("`allow_extension_operations` cannot be set to `true` when `provision_vm_agent` is set to `false`")
 	}
@@ -881,6 +889,14 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	var galleryApplications []interface{}
+	if props.ApplicationProfile != nil {
+		galleryApplications = flattenGalleryApplications(props.ApplicationProfile.GalleryApplications)
+	}
+	if err := d.Set("gallery_application", galleryApplications); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	encryptionAtHostEnabled := false
 	vtpmEnabled := false
 	secureBootEnabled := false
@@ -1241,6 +1257,14 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		update.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(notificationRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+
+		update.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(d.Get("gallery_application").([]interface{})),
+		}
+	}
+
 	if d.HasChange("tags") {
 		shouldUpdate = true
 
@@ -199,9 +199,9 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 		},
 	}
 
-	if galleryApplications := expandVirtualMachineScaleSetGalleryApplications(d.Get("gallery_applications").([]interface{})); galleryApplications != nil {
+	if galleryApplications := d.Get("gallery_applications").([]interface{}); len(galleryApplications) > 0 {
 		virtualMachineProfile.ApplicationProfile = &compute.ApplicationProfile{
-			GalleryApplications: galleryApplications,
+			GalleryApplications: expandGalleryApplications(galleryApplications),
 		}
 	}
 
@@ -912,7 +912,7 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 		d.Set("eviction_policy", string(profile.EvictionPolicy))
 
 		if profile.ApplicationProfile != nil && profile.ApplicationProfile.GalleryApplications != nil {
-			d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(profile.ApplicationProfile.GalleryApplications))
+			d.Set("gallery_applications", flattenGalleryApplications(profile.ApplicationProfile.GalleryApplications))
 		}
 
 		// the service just return empty when this is not assigned when provisioned
@@ -1260,7 +1260,7 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 		},
 
-		"gallery_applications": VirtualMachineScaleSetGalleryApplicationsSchema(),
+		"gallery_applications": galleryApplicationSchema(false),
 
 		"health_probe_id": {
 			Type:         pluginsdk.TypeString,
@@ -0,0 +1,221 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RestorePointResource struct{}
+
+var _ sdk.Resource = RestorePointResource{}
+
+type RestorePointModel struct {
+	Name                     string   `tfschema:"name"`
+	RestorePointCollectionId string   `tfschema:"restore_point_collection_id"`
+	ExcludedDiskIds          []string `tfschema:"excluded_disk_ids"`
+	ConsistencyMode          string   `tfschema:"consistency_mode"`
+	TimeCreated              string   `tfschema:"time_created"`
+}
+
+func (r RestorePointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointCollectionID,
+		},
+
+		"excluded_disk_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+		},
+
+		// Restore Points are Application Consistent by default, `CrashConsistent` is the only value the API accepts
+		"consistency_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(compute.ConsistencyModeTypesCrashConsistent),
+			}, false),
+		},
+	}
+}
+
+func (r RestorePointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"time_created": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r RestorePointResource) ResourceType() string {
+	return "azurerm_restore_point"
+}
+
+func (r RestorePointResource) ModelObject() interface{} {
+	return &RestorePointModel{}
+}
+
+func (r RestorePointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RestorePointID
+}
+
+func (r RestorePointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state RestorePointModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.RestorePointsClient
+
+			collectionId, err := parse.RestorePointCollectionID(state.RestorePointCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.Name, state.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			excludedDisks := make([]compute.APIEntityReference, 0)
+			for _, diskId := range state.ExcludedDiskIds {
+				excludedDisks = append(excludedDisks, compute.APIEntityReference{
+					ID: utils.String(diskId),
+				})
+			}
+
+			input := azuresdkhacks.RestorePoint{
+				Properties: azuresdkhacks.RestorePointProperties{
+					ExcludeDisks:    &excludedDisks,
+					ConsistencyMode: compute.ConsistencyModeTypes(state.ConsistencyMode),
+				},
+			}
+
+			future, err := azuresdkhacks.CreateRestorePoint(ctx, client, id.ResourceGroup, id.RestorePointCollectionName, id.Name, input)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r RestorePointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			collectionId := parse.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroup, id.RestorePointCollectionName)
+
+			state := RestorePointModel{
+				Name:                     id.Name,
+				RestorePointCollectionId: collectionId.ID(),
+				ExcludedDiskIds:          make([]string, 0),
+			}
+
+			if props := resp.RestorePointProperties; props != nil {
+				state.ConsistencyMode = string(props.ConsistencyMode)
+
+				if props.TimeCreated != nil {
+					state.TimeCreated = props.TimeCreated.Format(time.RFC3339)
+				}
+
+				if props.ExcludeDisks != nil {
+					for _, disk := range *props.ExcludeDisks {
+						if disk.ID == nil {
+							continue
+						}
+
+						diskId, err := parse.ManagedDiskID(*disk.ID)
+						if err != nil {
+							return fmt.Errorf("parsing `excluded_disk_ids`: %+v", err)
+						}
+						state.ExcludedDiskIds = append(state.ExcludedDiskIds, diskId.ID())
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r RestorePointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
@@ -164,6 +164,112 @@ func flattenBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
 	}
 }
 
+func galleryApplicationSchema(isVirtualMachine bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 100,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"package_reference_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     !isVirtualMachine,
+					ValidateFunc: validate.GalleryApplicationVersionID,
+				},
+
+				// Example: https://mystorageaccount.blob.core.windows.net/configurations/settings.config
+				"configuration_reference_blob_uri": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !isVirtualMachine,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"order": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ForceNew:     !isVirtualMachine,
+					ValidateFunc: validation.IntBetween(0, 2147483647),
+				},
+
+				// NOTE: Per the service team, "this is a pass through value that we just add to the model but don't depend on. It can be any string."
+				"tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !isVirtualMachine,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandGalleryApplications(input []interface{}) *[]compute.VMGalleryApplication {
+	// an empty list is returned rather than nil so that all of the Gallery Applications can be removed from a Virtual Machine
+	out := make([]compute.VMGalleryApplication, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		app := compute.VMGalleryApplication{
+			PackageReferenceID: utils.String(raw["package_reference_id"].(string)),
+			Order:              utils.Int32(int32(raw["order"].(int))),
+		}
+
+		if configurationReference := raw["configuration_reference_blob_uri"].(string); configurationReference != "" {
+			app.ConfigurationReference = utils.String(configurationReference)
+		}
+
+		if tag := raw["tag"].(string); tag != "" {
+			app.Tags = utils.String(tag)
+		}
+
+		out = append(out, app)
+	}
+
+	return &out
+}
+
+func flattenGalleryApplications(input *[]compute.VMGalleryApplication) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return nil
+	}
+
+	out := make([]interface{}, 0)
+
+	for _, v := range *input {
+		var packageReferenceId, configurationReference, tag string
+		var order int
+
+		if v.PackageReferenceID != nil {
+			packageReferenceId = *v.PackageReferenceID
+		}
+
+		if v.ConfigurationReference != nil {
+			configurationReference = *v.ConfigurationReference
+		}
+
+		if v.Order != nil {
+			order = int(*v.Order)
+		}
+
+		if v.Tags != nil {
+			tag = *v.Tags
+		}
+
+		out = append(out, map[string]interface{}{
+			"package_reference_id":             packageReferenceId,
+			"configuration_reference_blob_uri": configurationReference,
+			"order":                            order,
+			"tag":                              tag,
+		})
+	}
+
+	return out
+}
+
 func linuxSecretSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -0,0 +1,569 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+
+type VirtualMachineRunCommandModel struct {
+	Name                  string                                      `tfschema:"name"`
+	VirtualMachineId      string                                      `tfschema:"virtual_machine_id"`
+	Location              string                                      `tfschema:"location"`
+	Source                []VirtualMachineRunCommandSourceModel       `tfschema:"source"`
+	Parameter             []VirtualMachineRunCommandParameterModel    `tfschema:"parameter"`
+	ProtectedParameter    []VirtualMachineRunCommandParameterModel    `tfschema:"protected_parameter"`
+	AsyncExecutionEnabled bool                                        `tfschema:"async_execution_enabled"`
+	RunAsUser             string                                      `tfschema:"run_as_user"`
+	RunAsPassword         string                                      `tfschema:"run_as_password"`
+	TimeoutInSeconds      int                                         `tfschema:"timeout_in_seconds"`
+	OutputBlobUri         string                                      `tfschema:"output_blob_uri"`
+	ErrorBlobUri          string                                      `tfschema:"error_blob_uri"`
+	InstanceView          []VirtualMachineRunCommandInstanceViewModel `tfschema:"instance_view"`
+	Tags                  map[string]string                           `tfschema:"tags"`
+}
+
+type VirtualMachineRunCommandSourceModel struct {
+	Script    string `tfschema:"script"`
+	ScriptUri string `tfschema:"script_uri"`
+	CommandId string `tfschema:"command_id"`
+}
+
+type VirtualMachineRunCommandParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type VirtualMachineRunCommandInstanceViewModel struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int    `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+				},
+			},
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:      pluginsdk.TypeString,
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+
+		"async_execution_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandModel{}
+}
+
+func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineRunCommandID
+}
+
+func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state VirtualMachineRunCommandModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			virtualMachineId, err := parse.VirtualMachineID(state.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, state.Name)
+
+			existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(state.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(state),
+				Tags:                               tags.FromTypedObject(state.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, input)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 90 * time.Minute,
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the protected parameters and the password aren't returned by the API so we look these up from the config
+			var config VirtualMachineRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			virtualMachineId := parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+
+			state := VirtualMachineRunCommandModel{
+				Name:               id.RunCommandName,
+				VirtualMachineId:   virtualMachineId.ID(),
+				Location:           location.NormalizeNilable(resp.Location),
+				ProtectedParameter: config.ProtectedParameter,
+				RunAsPassword:      config.RunAsPassword,
+				Tags:               tags.ToTypedObject(resp.Tags),
+			}
+
+			if props := resp.VirtualMachineRunCommandProperties; props != nil {
+				state.Source = flattenVirtualMachineRunCommandSource(props.Source)
+				state.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+				state.AsyncExecutionEnabled = props.AsyncExecution != nil && *props.AsyncExecution
+				state.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+
+				if v := props.RunAsUser; v != nil {
+					state.RunAsUser = *v
+				}
+
+				if v := props.TimeoutInSeconds; v != nil {
+					state.TimeoutInSeconds = int(*v)
+				}
+
+				if v := props.OutputBlobURI; v != nil {
+					state.OutputBlobUri = *v
+				}
+
+				if v := props.ErrorBlobURI; v != nil {
+					state.ErrorBlobUri = *v
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state VirtualMachineRunCommandModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			// the protected parameters aren't returned by the API, so the whole payload is sent (and the script re-run) on any change
+			input := compute.VirtualMachineRunCommand{
+				Location:                           utils.String(location.Normalize(state.Location)),
+				VirtualMachineRunCommandProperties: expandVirtualMachineRunCommandProperties(state),
+				Tags:                               tags.FromTypedObject(state.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, input)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 90 * time.Minute,
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandVirtualMachineRunCommandProperties(input VirtualMachineRunCommandModel) *compute.VirtualMachineRunCommandProperties {
+	props := &compute.VirtualMachineRunCommandProperties{
+		Source:              expandVirtualMachineRunCommandSource(input.Source),
+		Parameters:          expandVirtualMachineRunCommandParameters(input.Parameter),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(input.ProtectedParameter),
+		AsyncExecution:      utils.Bool(input.AsyncExecutionEnabled),
+	}
+
+	if input.RunAsUser != "" {
+		props.RunAsUser = utils.String(input.RunAsUser)
+	}
+
+	if input.RunAsPassword != "" {
+		props.RunAsPassword = utils.String(input.RunAsPassword)
+	}
+
+	if input.TimeoutInSeconds != 0 {
+		props.TimeoutInSeconds = utils.Int32(int32(input.TimeoutInSeconds))
+	}
+
+	if input.OutputBlobUri != "" {
+		props.OutputBlobURI = utils.String(input.OutputBlobUri)
+	}
+
+	if input.ErrorBlobUri != "" {
+		props.ErrorBlobURI = utils.String(input.ErrorBlobUri)
+	}
+
+	return props
+}
+
+func expandVirtualMachineRunCommandSource(input []VirtualMachineRunCommandSourceModel) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	source := input[0]
+	output := &compute.VirtualMachineRunCommandScriptSource{}
+
+	if source.Script != "" {
+		output.Script = utils.String(source.Script)
+	}
+
+	if source.ScriptUri != "" {
+		output.ScriptURI = utils.String(source.ScriptUri)
+	}
+
+	if source.CommandId != "" {
+		output.CommandID = utils.String(source.CommandId)
+	}
+
+	return output
+}
+
+func expandVirtualMachineRunCommandParameters(input []VirtualMachineRunCommandParameterModel) *[]compute.RunCommandInputParameter {
+	output := make([]compute.RunCommandInputParameter, 0)
+
+	for _, v := range input {
+		output = append(output, compute.RunCommandInputParameter{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource) []VirtualMachineRunCommandSourceModel {
+	if input == nil {
+		return []VirtualMachineRunCommandSourceModel{}
+	}
+
+	source := VirtualMachineRunCommandSourceModel{}
+
+	if input.Script != nil {
+		source.Script = *input.Script
+	}
+
+	if input.ScriptURI != nil {
+		source.ScriptUri = *input.ScriptURI
+	}
+
+	if input.CommandID != nil {
+		source.CommandId = *input.CommandID
+	}
+
+	return []VirtualMachineRunCommandSourceModel{source}
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []VirtualMachineRunCommandParameterModel {
+	output := make([]VirtualMachineRunCommandParameterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		parameter := VirtualMachineRunCommandParameterModel{}
+
+		if v.Name != nil {
+			parameter.Name = *v.Name
+		}
+
+		if v.Value != nil {
+			parameter.Value = *v.Value
+		}
+
+		output = append(output, parameter)
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []VirtualMachineRunCommandInstanceViewModel {
+	if input == nil {
+		return []VirtualMachineRunCommandInstanceViewModel{}
+	}
+
+	instanceView := VirtualMachineRunCommandInstanceViewModel{
+		ExecutionState: string(input.ExecutionState),
+	}
+
+	if input.ExecutionMessage != nil {
+		instanceView.ExecutionMessage = *input.ExecutionMessage
+	}
+
+	if input.ExitCode != nil {
+		instanceView.ExitCode = int(*input.ExitCode)
+	}
+
+	if input.Output != nil {
+		instanceView.Output = *input.Output
+	}
+
+	if input.Error != nil {
+		instanceView.Error = *input.Error
+	}
+
+	if input.StartTime != nil {
+		instanceView.StartTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	if input.EndTime != nil {
+		instanceView.EndTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []VirtualMachineRunCommandInstanceViewModel{instanceView}
+}
@@ -208,6 +208,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(true),
+
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 			"license_type": {
@@ -707,6 +709,12 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		params.VirtualMachineProperties.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.VirtualMachineProperties.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(v.([]interface{})),
+		}
+	}
+
 	if v, ok := d.GetOk("timezone"); ok {
 		params.VirtualMachineProperties.OsProfile.WindowsConfiguration.TimeZone = utils.String(v.(string))
 	}
@@ -931,6 +939,14 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	var galleryApplications []interface{}
+	if props.ApplicationProfile != nil {
+		galleryApplications = flattenGalleryApplications(props.ApplicationProfile.GalleryApplications)
+	}
+	if err := d.Set("gallery_application", galleryApplications); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	encryptionAtHostEnabled := false
 	vtpmEnabled := false
 	secureBootEnabled := false
@@ -1305,6 +1321,14 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(notificationRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+
+		update.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(d.Get("gallery_application").([]interface{})),
+		}
+	}
+
 	if d.HasChange("additional_capabilities") {
 		shouldUpdate = true
 
@@ -196,9 +196,9 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		},
 	}
 
-	if galleryApplications := expandVirtualMachineScaleSetGalleryApplications(d.Get("gallery_applications").([]interface{})); galleryApplications != nil {
+	if galleryApplications := d.Get("gallery_applications").([]interface{}); len(galleryApplications) > 0 {
 		virtualMachineProfile.ApplicationProfile = &compute.ApplicationProfile{
-			GalleryApplications: galleryApplications,
+			GalleryApplications: expandGalleryApplications(galleryApplications),
 		}
 	}
 
@@ -945,7 +945,7 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 		d.Set("license_type", profile.LicenseType)
 
 		if profile.ApplicationProfile != nil && profile.ApplicationProfile.GalleryApplications != nil {
-			d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(profile.ApplicationProfile.GalleryApplications))
+			d.Set("gallery_applications", flattenGalleryApplications(profile.ApplicationProfile.GalleryApplications))
 		}
 
 		// the service just return empty when this is not assigned when provisioned
@@ -1291,7 +1291,7 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 			ValidateFunc: validate.ISO8601DurationBetween("PT15M", "PT2H"),
 		},
 
-		"gallery_applications": VirtualMachineScaleSetGalleryApplicationsSchema(),
+		"gallery_applications": galleryApplicationSchema(false),
 
 		"health_probe_id": {
 			Type:         pluginsdk.TypeString,
@@ -0,0 +1,71 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDnsZoneFile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsZoneFileRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+			},
+
+			"content": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsZoneFileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	zonesClient := meta.(*clients.Client).Dns.Zones
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := zones.ParseDnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(zone.HttpResponse) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	existing, err := listDnsZoneFileRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	recordSets := make([]zoneFileRecordSet, 0)
+	for _, key := range sortedZoneFileRecordSetKeys(existing) {
+		recordSets = append(recordSets, existing[key])
+	}
+
+	d.SetId(id.ID())
+	d.Set("dns_zone_id", id.ID())
+	d.Set("content", renderZoneFile(id.ZoneName, recordSets))
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsZoneFileDataSource struct{}
+
+func TestAccDataSourceDnsZoneFile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`(?m)^@\t\d+\tIN\tSOA\t`)),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`(?m)^www\t300\tIN\tA\t192\.0\.2\.2$`)),
+			),
+		},
+	})
+}
+
+func (DnsZoneFileDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone_file.test.dns_zone_id
+}
+`, DnsZoneFileResource{}.basic(data))
+}
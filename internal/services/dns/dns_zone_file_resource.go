@@ -0,0 +1,283 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDnsZoneFile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneFileCreateUpdate,
+		Read:   resourceDnsZoneFileRead,
+		Update: resourceDnsZoneFileCreateUpdate,
+		Delete: resourceDnsZoneFileDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := zones.ParseDnsZoneID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+			},
+
+			"content": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"owned_records_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"managed_record_sets": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// the zone name is only known once the `dns_zone_id` is, at which point the content can be validated
+			if zoneId, err := zones.ParseDnsZoneIDInsensitively(diff.Get("dns_zone_id").(string)); err == nil {
+				if _, err := parseZoneFile(diff.Get("content").(string), zoneId.ZoneName); err != nil {
+					return fmt.Errorf("parsing `content`: %+v", err)
+				}
+			}
+
+			if diff.HasChange("content") || diff.HasChange("owned_records_only") {
+				return diff.SetNewComputed("managed_record_sets")
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceDnsZoneFileCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	zonesClient := meta.(*clients.Client).Dns.Zones
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := zones.ParseDnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(zone.HttpResponse) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	desired, err := parseZoneFile(d.Get("content").(string), id.ZoneName)
+	if err != nil {
+		return fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	existing, err := listDnsZoneFileRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	var owned map[string]bool
+	if d.Get("owned_records_only").(bool) {
+		owned = make(map[string]bool)
+		old, _ := d.GetChange("managed_record_sets")
+		for _, v := range old.(*pluginsdk.Set).List() {
+			owned[v.(string)] = true
+		}
+	}
+
+	changes, err := diffZoneFileRecordSets(desired, existing, owned)
+	if err != nil {
+		return fmt.Errorf("reconciling %s: %+v", *id, err)
+	}
+
+	for _, change := range changes {
+		recordSet := change.RecordSet
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.ZoneName, recordSet.Type, recordSet.Name)
+		log.Printf("[DEBUG] %s: performing %s of %s..", *id, change.Action, recordSetId)
+
+		if change.Action == zoneFileRecordSetActionDelete {
+			if resp, err := client.Delete(ctx, recordSetId, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+			}
+			continue
+		}
+
+		props, err := expandZoneFileRecordSetProperties(recordSet)
+		if err != nil {
+			return fmt.Errorf("expanding %s: %+v", recordSetId, err)
+		}
+
+		parameters := recordsets.RecordSet{
+			Name:       utils.String(recordSet.Name),
+			Properties: props,
+		}
+		if _, err := client.CreateOrUpdate(ctx, recordSetId, parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", recordSetId, err)
+		}
+	}
+
+	if err := d.Set("managed_record_sets", zoneFileRecordSetKeys(desired)); err != nil {
+		return fmt.Errorf("setting `managed_record_sets`: %+v", err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDnsZoneFileRead(d, meta)
+}
+
+func resourceDnsZoneFileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	zonesClient := meta.(*clients.Client).Dns.Zones
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := zones.ParseDnsZoneIDInsensitively(d.Id())
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(zone.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	desired, err := parseZoneFile(d.Get("content").(string), id.ZoneName)
+	if err != nil {
+		return fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	existing, err := listDnsZoneFileRecordSets(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	ownedRecordsOnly := d.Get("owned_records_only").(bool)
+	var owned map[string]bool
+	if ownedRecordsOnly {
+		owned = make(map[string]bool)
+		for _, v := range d.Get("managed_record_sets").(*pluginsdk.Set).List() {
+			owned[v.(string)] = true
+		}
+	}
+
+	// the content is only updated when the zone has drifted, so that the formatting of the zone file is retained
+	if changes, err := diffZoneFileRecordSets(desired, existing, owned); err != nil || len(changes) > 0 {
+		current := make([]zoneFileRecordSet, 0)
+		for _, key := range zoneFileRecordSetKeys(existing) {
+			_, isDesired := desired[key]
+			if isDesired || owned == nil || owned[key] {
+				current = append(current, existing[key])
+			}
+		}
+		d.Set("content", renderZoneFile(id.ZoneName, current))
+	}
+
+	// when the zone file is authoritative every supported record set within the zone is managed by it
+	if !ownedRecordsOnly {
+		if err := d.Set("managed_record_sets", zoneFileRecordSetKeys(existing)); err != nil {
+			return fmt.Errorf("setting `managed_record_sets`: %+v", err)
+		}
+	}
+
+	d.Set("dns_zone_id", id.ID())
+	d.Set("owned_records_only", ownedRecordsOnly)
+
+	return nil
+}
+
+func resourceDnsZoneFileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := zones.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0)
+	if d.Get("owned_records_only").(bool) {
+		for _, v := range d.Get("managed_record_sets").(*pluginsdk.Set).List() {
+			keys = append(keys, v.(string))
+		}
+	} else {
+		desired, err := parseZoneFile(d.Get("content").(string), id.ZoneName)
+		if err != nil {
+			return fmt.Errorf("parsing `content`: %+v", err)
+		}
+		keys = zoneFileRecordSetKeys(desired)
+	}
+
+	for _, key := range keys {
+		recordType, name, err := parseZoneFileRecordSetKey(key)
+		if err != nil {
+			return err
+		}
+		if isZoneFileRecordSetManagedByAzure(recordType, name) {
+			continue
+		}
+
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.ZoneName, recordType, name)
+		if resp, err := client.Delete(ctx, recordSetId, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+		}
+	}
+
+	return nil
+}
+
+// listDnsZoneFileRecordSets returns the record sets within the zone which can be represented within a zone file
+func listDnsZoneFileRecordSets(ctx context.Context, client *recordsets.RecordSetsClient, id zones.DnsZoneId) (map[string]zoneFileRecordSet, error) {
+	zoneId := recordsets.NewDnsZoneID(id.SubscriptionId, id.ResourceGroupName, id.ZoneName)
+	result, err := client.ListAllByDnsZoneComplete(ctx, zoneId, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing record sets within %s: %+v", id, err)
+	}
+
+	output := make(map[string]zoneFileRecordSet)
+	for _, item := range result.Items {
+		if recordSet := flattenZoneFileRecordSet(item); recordSet != nil {
+			output[recordSet.key()] = *recordSet
+		}
+	}
+
+	return output, nil
+}
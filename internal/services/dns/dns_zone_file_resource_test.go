@@ -0,0 +1,214 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneFileResource struct{}
+
+func TestAccDnsZoneFile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_record_sets.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("content"),
+	})
+}
+
+func TestAccDnsZoneFile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_record_sets.#").HasValue("9"),
+			),
+		},
+		data.ImportStep("content"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_record_sets.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("content"),
+	})
+}
+
+func TestAccDnsZoneFile_ownedRecordsOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ownedRecordsOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_record_sets.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("content", "owned_records_only", "managed_record_sets"),
+	})
+}
+
+func TestAccDnsZoneFile_ownedRecordsOnlyConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ownedRecordsOnlyConflict(data),
+			ExpectError: regexp.MustCompile("already exists and is not managed by this zone file"),
+		},
+	})
+}
+
+func (DnsZoneFileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := zones.ParseDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.ZoneName, recordsets.RecordTypeA, "www")
+	resp, err := clients.Dns.RecordSets.Get(ctx, recordSetId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DnsZoneFileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneFileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  content     = <<ZONE
+$TTL 300
+@     IN A     192.0.2.1
+www   IN A     192.0.2.2
+mail  IN CNAME www
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneFileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  content     = <<ZONE
+$TTL 1h
+@          IN A     192.0.2.1
+@          IN A     192.0.2.3
+@     300  IN MX    10 mail
+@          IN CAA   0 issue "letsencrypt.org"
+@          IN TXT   "v=spf1 -all"
+www        IN A     192.0.2.2
+www        IN AAAA  2001:db8::2
+mail       IN A     192.0.2.10
+sub        IN NS    ns1.example.net.
+_sip._tcp  IN SRV   10 60 5060 mail
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneFileResource) ownedRecordsOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "unmanaged"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["192.0.2.100"]
+}
+
+resource "azurerm_dns_zone_file" "test" {
+  dns_zone_id        = azurerm_dns_zone.test.id
+  owned_records_only = true
+  content            = <<ZONE
+www   300 IN A     192.0.2.2
+www   300 IN TXT   "hello world"
+ZONE
+
+  depends_on = [azurerm_dns_a_record.test]
+}
+`, r.template(data))
+}
+
+func (r DnsZoneFileResource) ownedRecordsOnlyConflict(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "www"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["192.0.2.100"]
+}
+
+resource "azurerm_dns_zone_file" "test" {
+  dns_zone_id        = azurerm_dns_zone.test.id
+  owned_records_only = true
+  content            = <<ZONE
+www   300 IN A     192.0.2.2
+ZONE
+
+  depends_on = [azurerm_dns_a_record.test]
+}
+`, r.template(data))
+}
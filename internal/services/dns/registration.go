@@ -39,6 +39,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_srv_record":   dataSourceDnsSrvRecord(),
 		"azurerm_dns_txt_record":   dataSourceDnsTxtRecord(),
 		"azurerm_dns_zone":         dataSourceDnsZone(),
+		"azurerm_dns_zone_file":    dataSourceDnsZoneFile(),
 	}
 }
 
@@ -55,5 +56,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_srv_record":   resourceDnsSrvRecord(),
 		"azurerm_dns_txt_record":   resourceDnsTxtRecord(),
 		"azurerm_dns_zone":         resourceDnsZone(),
+		"azurerm_dns_zone_file":    resourceDnsZoneFile(),
 	}
 }
@@ -0,0 +1,841 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// dnsZoneFileDefaultTTL is used for records which don't specify a TTL when no `$TTL` directive is present
+const dnsZoneFileDefaultTTL = 3600
+
+// zoneFileRecordSet is a record set parsed from (or rendered to) an RFC 1035 zone file. Records are held in
+// their normalized presentation format (e.g. `10 mail.example.com.` for an MX record) and sorted, so that
+// record sets can be compared regardless of where they were sourced from.
+type zoneFileRecordSet struct {
+	Name    string
+	Type    recordsets.RecordType
+	TTL     int64
+	Records []string
+
+	// metadata is retained from the existing record set so that it isn't removed when the record set is updated
+	metadata *map[string]string
+}
+
+func (r zoneFileRecordSet) key() string {
+	return zoneFileRecordSetKey(r.Type, r.Name)
+}
+
+func (r zoneFileRecordSet) equals(other zoneFileRecordSet) bool {
+	if r.TTL != other.TTL || len(r.Records) != len(other.Records) {
+		return false
+	}
+
+	for i := range r.Records {
+		if r.Records[i] != other.Records[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func zoneFileRecordSetKey(recordType recordsets.RecordType, name string) string {
+	return fmt.Sprintf("%s/%s", recordType, strings.ToLower(name))
+}
+
+func parseZoneFileRecordSetKey(input string) (recordsets.RecordType, string, error) {
+	segments := strings.SplitN(input, "/", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("expected a record set key in the format `TYPE/name` but got %q", input)
+	}
+
+	return recordsets.RecordType(segments[0]), segments[1], nil
+}
+
+// isZoneFileRecordSetManagedByAzure returns whether the record set is maintained by Azure, the SOA and apex NS
+// record sets are created alongside the zone and so are never created or removed from a zone file
+func isZoneFileRecordSetManagedByAzure(recordType recordsets.RecordType, name string) bool {
+	return recordType == recordsets.RecordTypeSOA || (recordType == recordsets.RecordTypeNS && name == "@")
+}
+
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// zoneFileEntry is a single logical entry within a zone file, which may span multiple lines when parentheses are used
+type zoneFileEntry struct {
+	line         int
+	leadingBlank bool
+	tokens       []zoneFileToken
+}
+
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	entries := make([]zoneFileEntry, 0)
+	data := []byte(content)
+
+	line := 1
+	entry := zoneFileEntry{line: line}
+	token := make([]byte, 0)
+	hasToken := false
+	quoted := false
+	inQuote := false
+	parens := 0
+	lineStart := true
+
+	endToken := func() {
+		if hasToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{
+				value:  string(token),
+				quoted: quoted,
+			})
+		}
+		token = make([]byte, 0)
+		hasToken = false
+		quoted = false
+	}
+
+	endEntry := func() {
+		endToken()
+		if len(entry.tokens) > 0 {
+			entries = append(entries, entry)
+		}
+		entry = zoneFileEntry{line: line}
+	}
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		switch {
+		case c == '\\':
+			if i+1 >= len(data) || data[i+1] == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated escape sequence", line)
+			}
+			if i+3 < len(data) && isZoneFileDigit(data[i+1]) && isZoneFileDigit(data[i+2]) && isZoneFileDigit(data[i+3]) {
+				v, _ := strconv.Atoi(string(data[i+1 : i+4]))
+				if v > 255 {
+					return nil, fmt.Errorf("line %d: escape sequence `\\%s` is out of range", line, string(data[i+1:i+4]))
+				}
+				token = append(token, byte(v))
+				i += 3
+			} else {
+				token = append(token, data[i+1])
+				i++
+			}
+			hasToken = true
+
+		case inQuote:
+			if c == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+			if c == '"' {
+				inQuote = false
+				endToken()
+			} else {
+				token = append(token, c)
+			}
+
+		case c == '"':
+			endToken()
+			inQuote = true
+			quoted = true
+			hasToken = true
+
+		case c == ';':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			// process the newline (if any) on the next iteration
+			i--
+
+		case c == '(':
+			endToken()
+			parens++
+
+		case c == ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			parens--
+
+		case c == '\n':
+			line++
+			if parens > 0 {
+				endToken()
+			} else {
+				endEntry()
+				lineStart = true
+				continue
+			}
+
+		case c == ' ' || c == '\t' || c == '\r':
+			if lineStart {
+				entry.leadingBlank = true
+			}
+			endToken()
+
+		default:
+			token = append(token, c)
+			hasToken = true
+		}
+
+		lineStart = false
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	endEntry()
+
+	return entries, nil
+}
+
+func isZoneFileDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseZoneFile parses the RFC 1035 zone file `content` for the zone `zoneName`, returning the record sets keyed by
+// `TYPE/name`. SOA and apex NS records are skipped since these are maintained by Azure.
+func parseZoneFile(content string, zoneName string) (map[string]zoneFileRecordSet, error) {
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := absoluteZoneFileName(zoneName)
+	origin := zone
+	var defaultTTL, lastTTL *int64
+	previousOwner := ""
+	recordSets := make(map[string]zoneFileRecordSet)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if first := tokens[0]; !entry.leadingBlank && !first.quoted && strings.HasPrefix(first.value, "$") {
+			switch strings.ToUpper(first.value) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$ORIGIN` expects a single domain name", entry.line)
+				}
+				origin = qualifyZoneFileName(tokens[1].value, origin)
+
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$TTL` expects a single value", entry.line)
+				}
+				ttl, err := parseZoneFileTTL(tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", entry.line, err)
+				}
+				defaultTTL = &ttl
+
+			default:
+				return nil, fmt.Errorf("line %d: the `%s` directive is not supported", entry.line, first.value)
+			}
+			continue
+		}
+
+		owner := previousOwner
+		if !entry.leadingBlank {
+			owner = qualifyZoneFileName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		previousOwner = owner
+
+		// the TTL and class are both optional and can be specified in either order
+		var ttl *int64
+		classSpecified := false
+		for len(tokens) > 0 && !tokens[0].quoted {
+			value := tokens[0].value
+			if !classSpecified && strings.EqualFold(value, "IN") {
+				classSpecified = true
+				tokens = tokens[1:]
+				continue
+			}
+			if !classSpecified && (strings.EqualFold(value, "CH") || strings.EqualFold(value, "CS") || strings.EqualFold(value, "HS")) {
+				return nil, fmt.Errorf("line %d: only the `IN` class is supported but got %q", entry.line, value)
+			}
+			if ttl == nil {
+				if v, err := parseZoneFileTTL(value); err == nil {
+					ttl = &v
+					tokens = tokens[1:]
+					continue
+				}
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record is missing a type", entry.line)
+		}
+
+		if ttl != nil {
+			lastTTL = ttl
+		} else {
+			switch {
+			case defaultTTL != nil:
+				ttl = defaultTTL
+			case lastTTL != nil:
+				ttl = lastTTL
+			default:
+				ttl = utils.Int64(dnsZoneFileDefaultTTL)
+			}
+		}
+
+		name, err := relativeZoneFileName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", entry.line, err)
+		}
+
+		recordType := recordsets.RecordType(strings.ToUpper(tokens[0].value))
+		if isZoneFileRecordSetManagedByAzure(recordType, name) {
+			continue
+		}
+
+		value, err := normalizeZoneFileRecord(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing %s record %q: %+v", entry.line, recordType, name, err)
+		}
+
+		// a Record Set has a single TTL, so each record within it must have the same TTL
+		key := zoneFileRecordSetKey(recordType, name)
+		recordSet, ok := recordSets[key]
+		if !ok {
+			recordSet = zoneFileRecordSet{
+				Name: name,
+				Type: recordType,
+				TTL:  *ttl,
+			}
+		} else if recordSet.TTL != *ttl {
+			return nil, fmt.Errorf("line %d: the TTL of %d for the %s record %q differs from the TTL of %d for the other records in the Record Set", entry.line, *ttl, recordType, name, recordSet.TTL)
+		}
+		if !utils.SliceContainsValue(recordSet.Records, value) {
+			recordSet.Records = append(recordSet.Records, value)
+		}
+		recordSets[key] = recordSet
+	}
+
+	for _, key := range sortedZoneFileRecordSetKeys(recordSets) {
+		recordSet := recordSets[key]
+		sort.Strings(recordSet.Records)
+		recordSets[key] = recordSet
+
+		if recordSet.Type != recordsets.RecordTypeCNAME {
+			continue
+		}
+		if len(recordSet.Records) > 1 {
+			return nil, fmt.Errorf("only a single CNAME record can be specified for %q", recordSet.Name)
+		}
+		for _, other := range recordSets {
+			if other.Name == recordSet.Name && other.Type != recordsets.RecordTypeCNAME {
+				return nil, fmt.Errorf("a CNAME record for %q cannot coexist with %s records", recordSet.Name, other.Type)
+			}
+		}
+	}
+
+	return recordSets, nil
+}
+
+// parseZoneFileTTL parses a TTL either as a number of seconds or in the BIND format (e.g. `1h30m`)
+func parseZoneFileTTL(input string) (int64, error) {
+	if input == "" || !isZoneFileDigit(input[0]) {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	if v, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if v > 2147483647 {
+			return 0, fmt.Errorf("TTL %q must be less than 2147483648", input)
+		}
+		return v, nil
+	}
+
+	units := map[byte]int64{
+		'w': 604800,
+		'd': 86400,
+		'h': 3600,
+		'm': 60,
+		's': 1,
+	}
+
+	total := int64(0)
+	current := ""
+	for _, c := range []byte(strings.ToLower(input)) {
+		if isZoneFileDigit(c) {
+			current += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c]
+		if !ok || current == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		v, err := strconv.ParseInt(current, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		current = ""
+	}
+
+	if current != "" {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+	if total > 2147483647 {
+		return 0, fmt.Errorf("TTL %q must be less than 2147483648", input)
+	}
+
+	return total, nil
+}
+
+func absoluteZoneFileName(input string) string {
+	name := strings.ToLower(input)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func qualifyZoneFileName(input string, origin string) string {
+	if input == "@" {
+		return origin
+	}
+
+	name := strings.ToLower(input)
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", name, origin)
+}
+
+func relativeZoneFileName(fqdn string, zone string) (string, error) {
+	if fqdn == zone {
+		return "@", nil
+	}
+
+	if strings.HasSuffix(fqdn, "."+zone) {
+		return strings.TrimSuffix(fqdn, "."+zone), nil
+	}
+
+	return "", fmt.Errorf("%q is not within the zone %q", fqdn, zone)
+}
+
+func quoteZoneFileString(input string) string {
+	value := strings.ReplaceAll(input, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, value)
+}
+
+// normalizeZoneFileRecord validates the RDATA for a record and returns it in its normalized presentation format
+func normalizeZoneFileRecord(recordType recordsets.RecordType, rdata []zoneFileToken, origin string) (string, error) {
+	expect := func(count int) ([]string, error) {
+		if len(rdata) != count {
+			return nil, fmt.Errorf("expected %d values but got %d", count, len(rdata))
+		}
+		values := make([]string, 0)
+		for _, v := range rdata {
+			values = append(values, v.value)
+		}
+		return values, nil
+	}
+
+	switch recordType {
+	case recordsets.RecordTypeA:
+		values, err := expect(1)
+		if err != nil {
+			return "", err
+		}
+		ip := net.ParseIP(values[0])
+		if ip == nil || ip.To4() == nil || strings.Contains(values[0], ":") {
+			return "", fmt.Errorf("%q is not a valid IPv4 address", values[0])
+		}
+		return ip.To4().String(), nil
+
+	case recordsets.RecordTypeAAAA:
+		values, err := expect(1)
+		if err != nil {
+			return "", err
+		}
+		ip := net.ParseIP(values[0])
+		if ip == nil || !strings.Contains(values[0], ":") {
+			return "", fmt.Errorf("%q is not a valid IPv6 address", values[0])
+		}
+		return ip.String(), nil
+
+	case recordsets.RecordTypeCNAME, recordsets.RecordTypeNS, recordsets.RecordTypePTR:
+		values, err := expect(1)
+		if err != nil {
+			return "", err
+		}
+		return qualifyZoneFileName(values[0], origin), nil
+
+	case recordsets.RecordTypeMX:
+		values, err := expect(2)
+		if err != nil {
+			return "", err
+		}
+		preference, err := strconv.ParseUint(values[0], 10, 16)
+		if err != nil {
+			return "", fmt.Errorf("preference %q must be between 0 and 65535", values[0])
+		}
+		return fmt.Sprintf("%d %s", preference, qualifyZoneFileName(values[1], origin)), nil
+
+	case recordsets.RecordTypeSRV:
+		values, err := expect(4)
+		if err != nil {
+			return "", err
+		}
+		fields := make([]uint64, 0)
+		for i, field := range []string{"priority", "weight", "port"} {
+			v, err := strconv.ParseUint(values[i], 10, 16)
+			if err != nil {
+				return "", fmt.Errorf("%s %q must be between 0 and 65535", field, values[i])
+			}
+			fields = append(fields, v)
+		}
+		return fmt.Sprintf("%d %d %d %s", fields[0], fields[1], fields[2], qualifyZoneFileName(values[3], origin)), nil
+
+	case recordsets.RecordTypeCAA:
+		values, err := expect(3)
+		if err != nil {
+			return "", err
+		}
+		flags, err := strconv.ParseUint(values[0], 10, 8)
+		if err != nil {
+			return "", fmt.Errorf("flags %q must be between 0 and 255", values[0])
+		}
+		return fmt.Sprintf("%d %s %s", flags, strings.ToLower(values[1]), quoteZoneFileString(values[2])), nil
+
+	case recordsets.RecordTypeTXT:
+		if len(rdata) == 0 {
+			return "", fmt.Errorf("expected at least one value")
+		}
+		values := make([]string, 0)
+		for _, v := range rdata {
+			if len(v.value) > 255 {
+				return "", fmt.Errorf("character strings must be at most 255 characters but got %d", len(v.value))
+			}
+			values = append(values, quoteZoneFileString(v.value))
+		}
+		return strings.Join(values, " "), nil
+	}
+
+	return "", fmt.Errorf("record type %q is not supported", recordType)
+}
+
+// renderZoneFile renders the record sets as an RFC 1035 zone file, the SOA and apex NS record sets are
+// rendered first with all other record sets sorted by name and type
+func renderZoneFile(zoneName string, input []zoneFileRecordSet) string {
+	recordSets := make([]zoneFileRecordSet, len(input))
+	copy(recordSets, input)
+
+	rank := func(r zoneFileRecordSet) int {
+		switch {
+		case r.Type == recordsets.RecordTypeSOA:
+			return 0
+		case r.Type == recordsets.RecordTypeNS && r.Name == "@":
+			return 1
+		case r.Name == "@":
+			return 2
+		}
+		return 3
+	}
+
+	sort.Slice(recordSets, func(i, j int) bool {
+		if rank(recordSets[i]) != rank(recordSets[j]) {
+			return rank(recordSets[i]) < rank(recordSets[j])
+		}
+		if recordSets[i].Name != recordSets[j].Name {
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n", absoluteZoneFileName(zoneName)))
+	for _, recordSet := range recordSets {
+		for _, record := range recordSet.Records {
+			sb.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s\n", recordSet.Name, recordSet.TTL, recordSet.Type, record))
+		}
+	}
+
+	return sb.String()
+}
+
+func sortedZoneFileRecordSetKeys(input map[string]zoneFileRecordSet) []string {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// flattenZoneFileRecordSet converts a Record Set from the API into a zoneFileRecordSet, returning nil for
+// alias record sets and unsupported record types since these can't be represented within a zone file
+func flattenZoneFileRecordSet(input recordsets.RecordSet) *zoneFileRecordSet {
+	if input.Name == nil || input.Type == nil || input.Properties == nil {
+		return nil
+	}
+
+	props := input.Properties
+	if props.TargetResource != nil && props.TargetResource.Id != nil {
+		return nil
+	}
+
+	typeSegments := strings.Split(*input.Type, "/")
+	recordSet := zoneFileRecordSet{
+		Name:     strings.ToLower(*input.Name),
+		Type:     recordsets.RecordType(strings.ToUpper(typeSegments[len(typeSegments)-1])),
+		Records:  make([]string, 0),
+		metadata: props.Metadata,
+	}
+	if props.TTL != nil {
+		recordSet.TTL = *props.TTL
+	}
+
+	switch recordSet.Type {
+	case recordsets.RecordTypeA:
+		if props.ARecords != nil {
+			for _, v := range *props.ARecords {
+				if v.IPv4Address != nil {
+					recordSet.Records = append(recordSet.Records, normalizeZoneFileIPAddress(*v.IPv4Address))
+				}
+			}
+		}
+
+	case recordsets.RecordTypeAAAA:
+		if props.AAAARecords != nil {
+			for _, v := range *props.AAAARecords {
+				if v.IPv6Address != nil {
+					recordSet.Records = append(recordSet.Records, normalizeZoneFileIPAddress(*v.IPv6Address))
+				}
+			}
+		}
+
+	case recordsets.RecordTypeCAA:
+		if props.CaaRecords != nil {
+			for _, v := range *props.CaaRecords {
+				flags := int64(0)
+				if v.Flags != nil {
+					flags = *v.Flags
+				}
+				recordSet.Records = append(recordSet.Records, fmt.Sprintf("%d %s %s", flags, strings.ToLower(utils.NormalizeNilableString(v.Tag)), quoteZoneFileString(utils.NormalizeNilableString(v.Value))))
+			}
+		}
+
+	case recordsets.RecordTypeCNAME:
+		if props.CNAMERecord != nil && props.CNAMERecord.Cname != nil {
+			recordSet.Records = append(recordSet.Records, absoluteZoneFileName(*props.CNAMERecord.Cname))
+		}
+
+	case recordsets.RecordTypeMX:
+		if props.MXRecords != nil {
+			for _, v := range *props.MXRecords {
+				preference := int64(0)
+				if v.Preference != nil {
+					preference = *v.Preference
+				}
+				recordSet.Records = append(recordSet.Records, fmt.Sprintf("%d %s", preference, absoluteZoneFileName(utils.NormalizeNilableString(v.Exchange))))
+			}
+		}
+
+	case recordsets.RecordTypeNS:
+		if props.NSRecords != nil {
+			for _, v := range *props.NSRecords {
+				if v.Nsdname != nil {
+					recordSet.Records = append(recordSet.Records, absoluteZoneFileName(*v.Nsdname))
+				}
+			}
+		}
+
+	case recordsets.RecordTypePTR:
+		if props.PTRRecords != nil {
+			for _, v := range *props.PTRRecords {
+				if v.Ptrdname != nil {
+					recordSet.Records = append(recordSet.Records, absoluteZoneFileName(*v.Ptrdname))
+				}
+			}
+		}
+
+	case recordsets.RecordTypeSOA:
+		if v := props.SOARecord; v != nil {
+			values := make([]string, 0)
+			for _, field := range []*int64{v.SerialNumber, v.RefreshTime, v.RetryTime, v.ExpireTime, v.MinimumTTL} {
+				value := int64(0)
+				if field != nil {
+					value = *field
+				}
+				values = append(values, strconv.FormatInt(value, 10))
+			}
+			recordSet.Records = append(recordSet.Records, fmt.Sprintf("%s %s %s", absoluteZoneFileName(utils.NormalizeNilableString(v.Host)), absoluteZoneFileName(utils.NormalizeNilableString(v.Email)), strings.Join(values, " ")))
+		}
+
+	case recordsets.RecordTypeSRV:
+		if props.SRVRecords != nil {
+			for _, v := range *props.SRVRecords {
+				fields := make([]int64, 0)
+				for _, field := range []*int64{v.Priority, v.Weight, v.Port} {
+					value := int64(0)
+					if field != nil {
+						value = *field
+					}
+					fields = append(fields, value)
+				}
+				recordSet.Records = append(recordSet.Records, fmt.Sprintf("%d %d %d %s", fields[0], fields[1], fields[2], absoluteZoneFileName(utils.NormalizeNilableString(v.Target))))
+			}
+		}
+
+	case recordsets.RecordTypeTXT:
+		if props.TXTRecords != nil {
+			for _, v := range *props.TXTRecords {
+				values := make([]string, 0)
+				if v.Value != nil {
+					for _, value := range *v.Value {
+						values = append(values, quoteZoneFileString(value))
+					}
+				}
+				recordSet.Records = append(recordSet.Records, strings.Join(values, " "))
+			}
+		}
+
+	default:
+		return nil
+	}
+
+	sort.Strings(recordSet.Records)
+	return &recordSet
+}
+
+func normalizeZoneFileIPAddress(input string) string {
+	if ip := net.ParseIP(input); ip != nil {
+		return ip.String()
+	}
+	return input
+}
+
+// expandZoneFileRecordSetProperties converts a zoneFileRecordSet into the Record Set properties used by the API
+func expandZoneFileRecordSetProperties(input zoneFileRecordSet) (*recordsets.RecordSetProperties, error) {
+	props := recordsets.RecordSetProperties{
+		Metadata: input.metadata,
+		TTL:      utils.Int64(input.TTL),
+	}
+
+	aRecords := make([]recordsets.ARecord, 0)
+	aaaaRecords := make([]recordsets.AaaaRecord, 0)
+	caaRecords := make([]recordsets.CaaRecord, 0)
+	mxRecords := make([]recordsets.MxRecord, 0)
+	nsRecords := make([]recordsets.NsRecord, 0)
+	ptrRecords := make([]recordsets.PtrRecord, 0)
+	srvRecords := make([]recordsets.SrvRecord, 0)
+	txtRecords := make([]recordsets.TxtRecord, 0)
+
+	for _, record := range input.Records {
+		entries, err := tokenizeZoneFile(record)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) != 1 {
+			return nil, fmt.Errorf("parsing %s record %q", input.Type, record)
+		}
+		fields := make([]string, 0)
+		for _, v := range entries[0].tokens {
+			fields = append(fields, v.value)
+		}
+
+		switch input.Type {
+		case recordsets.RecordTypeA:
+			aRecords = append(aRecords, recordsets.ARecord{
+				IPv4Address: utils.String(fields[0]),
+			})
+
+		case recordsets.RecordTypeAAAA:
+			aaaaRecords = append(aaaaRecords, recordsets.AaaaRecord{
+				IPv6Address: utils.String(fields[0]),
+			})
+
+		case recordsets.RecordTypeCAA:
+			flags, _ := strconv.ParseInt(fields[0], 10, 64)
+			caaRecords = append(caaRecords, recordsets.CaaRecord{
+				Flags: utils.Int64(flags),
+				Tag:   utils.String(fields[1]),
+				Value: utils.String(fields[2]),
+			})
+
+		case recordsets.RecordTypeCNAME:
+			props.CNAMERecord = &recordsets.CnameRecord{
+				Cname: utils.String(strings.TrimSuffix(fields[0], ".")),
+			}
+
+		case recordsets.RecordTypeMX:
+			preference, _ := strconv.ParseInt(fields[0], 10, 64)
+			mxRecords = append(mxRecords, recordsets.MxRecord{
+				Preference: utils.Int64(preference),
+				Exchange:   utils.String(strings.TrimSuffix(fields[1], ".")),
+			})
+
+		case recordsets.RecordTypeNS:
+			nsRecords = append(nsRecords, recordsets.NsRecord{
+				Nsdname: utils.String(strings.TrimSuffix(fields[0], ".")),
+			})
+
+		case recordsets.RecordTypePTR:
+			ptrRecords = append(ptrRecords, recordsets.PtrRecord{
+				Ptrdname: utils.String(strings.TrimSuffix(fields[0], ".")),
+			})
+
+		case recordsets.RecordTypeSRV:
+			priority, _ := strconv.ParseInt(fields[0], 10, 64)
+			weight, _ := strconv.ParseInt(fields[1], 10, 64)
+			port, _ := strconv.ParseInt(fields[2], 10, 64)
+			srvRecords = append(srvRecords, recordsets.SrvRecord{
+				Priority: utils.Int64(priority),
+				Weight:   utils.Int64(weight),
+				Port:     utils.Int64(port),
+				Target:   utils.String(strings.TrimSuffix(fields[3], ".")),
+			})
+
+		case recordsets.RecordTypeTXT:
+			value := fields
+			txtRecords = append(txtRecords, recordsets.TxtRecord{
+				Value: &value,
+			})
+
+		default:
+			return nil, fmt.Errorf("record type %q is not supported", input.Type)
+		}
+	}
+
+	switch input.Type {
+	case recordsets.RecordTypeA:
+		props.ARecords = &aRecords
+	case recordsets.RecordTypeAAAA:
+		props.AAAARecords = &aaaaRecords
+	case recordsets.RecordTypeCAA:
+		props.CaaRecords = &caaRecords
+	case recordsets.RecordTypeMX:
+		props.MXRecords = &mxRecords
+	case recordsets.RecordTypeNS:
+		props.NSRecords = &nsRecords
+	case recordsets.RecordTypePTR:
+		props.PTRRecords = &ptrRecords
+	case recordsets.RecordTypeSRV:
+		props.SRVRecords = &srvRecords
+	case recordsets.RecordTypeTXT:
+		props.TXTRecords = &txtRecords
+	}
+
+	return &props, nil
+}
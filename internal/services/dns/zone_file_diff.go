@@ -0,0 +1,98 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+)
+
+type zoneFileRecordSetAction string
+
+const (
+	zoneFileRecordSetActionCreate zoneFileRecordSetAction = "create"
+	zoneFileRecordSetActionUpdate zoneFileRecordSetAction = "update"
+	zoneFileRecordSetActionDelete zoneFileRecordSetAction = "delete"
+)
+
+type zoneFileRecordSetChange struct {
+	Action    zoneFileRecordSetAction
+	RecordSet zoneFileRecordSet
+}
+
+// diffZoneFileRecordSets determines the changes required to reconcile the existing record sets within a zone with
+// the desired record sets parsed from a zone file.
+//
+// When `owned` is nil the zone file is authoritative, meaning any existing record set of a supported type which
+// isn't present in the zone file is removed. Otherwise only record sets within `owned` (those previously created
+// from the zone file) are removed, and record sets which exist but aren't owned can't be managed.
+//
+// Changes are returned as deletions, then updates, then creations - each sorted by key.
+func diffZoneFileRecordSets(desired, existing map[string]zoneFileRecordSet, owned map[string]bool) ([]zoneFileRecordSetChange, error) {
+	deletes := make([]zoneFileRecordSetChange, 0)
+	updates := make([]zoneFileRecordSetChange, 0)
+	creates := make([]zoneFileRecordSetChange, 0)
+
+	for _, key := range sortedZoneFileRecordSetKeys(desired) {
+		recordSet := desired[key]
+		if isZoneFileRecordSetManagedByAzure(recordSet.Type, recordSet.Name) {
+			continue
+		}
+
+		current, exists := existing[key]
+		if !exists {
+			creates = append(creates, zoneFileRecordSetChange{
+				Action:    zoneFileRecordSetActionCreate,
+				RecordSet: recordSet,
+			})
+			continue
+		}
+
+		if owned != nil && !owned[key] {
+			return nil, fmt.Errorf("the %s record set %q already exists and is not managed by this zone file - to be managed it must be removed from the zone first", recordSet.Type, recordSet.Name)
+		}
+
+		if !recordSet.equals(current) {
+			recordSet.metadata = current.metadata
+			updates = append(updates, zoneFileRecordSetChange{
+				Action:    zoneFileRecordSetActionUpdate,
+				RecordSet: recordSet,
+			})
+		}
+	}
+
+	for _, key := range sortedZoneFileRecordSetKeys(existing) {
+		recordSet := existing[key]
+		if isZoneFileRecordSetManagedByAzure(recordSet.Type, recordSet.Name) {
+			continue
+		}
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if owned != nil && !owned[key] {
+			continue
+		}
+
+		deletes = append(deletes, zoneFileRecordSetChange{
+			Action:    zoneFileRecordSetActionDelete,
+			RecordSet: recordSet,
+		})
+	}
+
+	changes := make([]zoneFileRecordSetChange, 0)
+	changes = append(changes, deletes...)
+	changes = append(changes, updates...)
+	changes = append(changes, creates...)
+	return changes, nil
+}
+
+// zoneFileRecordSetKeys returns the sorted keys of the record sets which are managed from a zone file
+func zoneFileRecordSetKeys(input map[string]zoneFileRecordSet) []string {
+	keys := make([]string, 0)
+	for key, recordSet := range input {
+		if isZoneFileRecordSetManagedByAzure(recordSet.Type, recordSet.Name) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
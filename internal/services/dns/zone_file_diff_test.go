@@ -0,0 +1,97 @@
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+)
+
+func TestDiffZoneFileRecordSets(t *testing.T) {
+	metadata := map[string]string{"hello": "world"}
+	existing := map[string]zoneFileRecordSet{
+		"SOA/@": {Name: "@", Type: recordsets.RecordTypeSOA, TTL: 3600, Records: []string{"ns1. hostmaster. 1 3600 300 2419200 300"}},
+		"NS/@":  {Name: "@", Type: recordsets.RecordTypeNS, TTL: 172800, Records: []string{"ns1-01.azure-dns.com."}},
+		"A/www": {Name: "www", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.1"}, metadata: &metadata},
+		"A/old": {Name: "old", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.2"}},
+		"A/api": {Name: "api", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.3"}},
+	}
+	desired := map[string]zoneFileRecordSet{
+		"A/www":  {Name: "www", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.1", "192.0.2.4"}},
+		"A/api":  {Name: "api", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.3"}},
+		"MX/@":   {Name: "@", Type: recordsets.RecordTypeMX, TTL: 300, Records: []string{"10 mail.example.com."}},
+		"TXT/@":  {Name: "@", Type: recordsets.RecordTypeTXT, TTL: 300, Records: []string{`"hello"`}},
+		"A/mail": {Name: "mail", Type: recordsets.RecordTypeA, TTL: 300, Records: []string{"192.0.2.5"}},
+	}
+
+	cases := []struct {
+		Name     string
+		Owned    map[string]bool
+		Expected []string
+		Error    string
+	}{
+		{
+			Name:     "authoritative",
+			Owned:    nil,
+			Expected: []string{"delete A/old", "update A/www", "create A/mail", "create MX/@", "create TXT/@"},
+		},
+		{
+			Name:     "owned",
+			Owned:    map[string]bool{"A/www": true, "A/api": true},
+			Expected: []string{"update A/www", "create A/mail", "create MX/@", "create TXT/@"},
+		},
+		{
+			Name:     "owned removes owned records only",
+			Owned:    map[string]bool{"A/www": true, "A/api": true, "A/old": true},
+			Expected: []string{"delete A/old", "update A/www", "create A/mail", "create MX/@", "create TXT/@"},
+		},
+		{
+			Name:  "owned conflicts with unowned record set",
+			Owned: map[string]bool{"A/www": true},
+			Error: `the A record set "api" already exists`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			changes, err := diffZoneFileRecordSets(desired, existing, tc.Owned)
+			if tc.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.Error) {
+					t.Fatalf("expected an error containing %q but got %+v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			actual := make([]string, 0)
+			for _, change := range changes {
+				actual = append(actual, string(change.Action)+" "+change.RecordSet.key())
+
+				if change.Action == zoneFileRecordSetActionUpdate && change.RecordSet.metadata != &metadata {
+					t.Fatalf("expected the existing metadata to be retained for %q", change.RecordSet.key())
+				}
+			}
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestDiffZoneFileRecordSetsNoChanges(t *testing.T) {
+	recordSets, err := parseZoneFile(testZoneFileContent, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	changes, err := diffZoneFileRecordSets(recordSets, recordSets, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes but got %+v", changes)
+	}
+}
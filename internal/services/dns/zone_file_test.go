@@ -0,0 +1,359 @@
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const testZoneFileContent = `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1-01.azure-dns.com. azuredns-hostmaster.microsoft.com. (
+		1	; serial
+		3600	; refresh
+		300	; retry
+		2419200	; expire
+		300 )	; minimum
+@		IN	NS	ns1-01.azure-dns.com.
+@		IN	A	192.0.2.1
+@		IN	A	192.0.2.2
+@	300	IN	MX	10 mail
+@	300	IN	MX	20 mail.backup.example.net.
+@		IN	CAA	0 issue "letsencrypt.org"
+@		IN	TXT	"v=spf1 -all"
+www	IN	300	CNAME	example.com.
+mail		A	192.0.2.10
+		AAAA	2001:0db8:0000:0000:0000:0000:0000:0001
+sub		NS	ns1.delegated.example.net.
+_sip._tcp	SRV	10 60 5060 sip
+txt		TXT	"first \"quoted\" chunk" "second chunk"
+$ORIGIN 2.0.192.example.com.
+10		PTR	mail.example.com.
+`
+
+func TestParseZoneFile(t *testing.T) {
+	actual, err := parseZoneFile(testZoneFileContent, "Example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := map[string]zoneFileRecordSet{
+		"A/@": {
+			Name:    "@",
+			Type:    recordsets.RecordTypeA,
+			TTL:     3600,
+			Records: []string{"192.0.2.1", "192.0.2.2"},
+		},
+		"MX/@": {
+			Name:    "@",
+			Type:    recordsets.RecordTypeMX,
+			TTL:     300,
+			Records: []string{"10 mail.example.com.", "20 mail.backup.example.net."},
+		},
+		"CAA/@": {
+			Name:    "@",
+			Type:    recordsets.RecordTypeCAA,
+			TTL:     3600,
+			Records: []string{`0 issue "letsencrypt.org"`},
+		},
+		"TXT/@": {
+			Name:    "@",
+			Type:    recordsets.RecordTypeTXT,
+			TTL:     3600,
+			Records: []string{`"v=spf1 -all"`},
+		},
+		"CNAME/www": {
+			Name:    "www",
+			Type:    recordsets.RecordTypeCNAME,
+			TTL:     300,
+			Records: []string{"example.com."},
+		},
+		"A/mail": {
+			Name:    "mail",
+			Type:    recordsets.RecordTypeA,
+			TTL:     3600,
+			Records: []string{"192.0.2.10"},
+		},
+		"AAAA/mail": {
+			Name:    "mail",
+			Type:    recordsets.RecordTypeAAAA,
+			TTL:     3600,
+			Records: []string{"2001:db8::1"},
+		},
+		"NS/sub": {
+			Name:    "sub",
+			Type:    recordsets.RecordTypeNS,
+			TTL:     3600,
+			Records: []string{"ns1.delegated.example.net."},
+		},
+		"SRV/_sip._tcp": {
+			Name:    "_sip._tcp",
+			Type:    recordsets.RecordTypeSRV,
+			TTL:     3600,
+			Records: []string{"10 60 5060 sip.example.com."},
+		},
+		"TXT/txt": {
+			Name:    "txt",
+			Type:    recordsets.RecordTypeTXT,
+			TTL:     3600,
+			Records: []string{`"first \"quoted\" chunk" "second chunk"`},
+		},
+		"PTR/10.2.0.192": {
+			Name:    "10.2.0.192",
+			Type:    recordsets.RecordTypePTR,
+			TTL:     3600,
+			Records: []string{"mail.example.com."},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected:\n%+v\n\ngot:\n%+v", expected, actual)
+	}
+}
+
+func TestParseZoneFileTTLInheritance(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected map[string]int64
+	}{
+		{
+			Name:     "default",
+			Content:  "a A 192.0.2.1\n",
+			Expected: map[string]int64{"A/a": 3600},
+		},
+		{
+			Name:     "last explicit",
+			Content:  "a 60 A 192.0.2.1\nb A 192.0.2.2\n",
+			Expected: map[string]int64{"A/a": 60, "A/b": 60},
+		},
+		{
+			Name:     "directive wins over last explicit",
+			Content:  "$TTL 120\na 60 A 192.0.2.1\nb A 192.0.2.2\n",
+			Expected: map[string]int64{"A/a": 60, "A/b": 120},
+		},
+		{
+			Name:     "class before ttl",
+			Content:  "a IN 1d A 192.0.2.1\n",
+			Expected: map[string]int64{"A/a": 86400},
+		},
+		{
+			Name:     "same ttl within a record set",
+			Content:  "$TTL 60\na A 192.0.2.1\na 60 A 192.0.2.2\n",
+			Expected: map[string]int64{"A/a": 60},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			recordSets, err := parseZoneFile(tc.Content, "example.com")
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			actual := make(map[string]int64)
+			for k, v := range recordSets {
+				actual[k] = v.TTL
+			}
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Content string
+		Error   string
+	}{
+		{
+			Name:    "unterminated quote",
+			Content: "a TXT \"hello\n",
+			Error:   "unterminated quoted string",
+		},
+		{
+			Name:    "unbalanced parentheses",
+			Content: "a MX ( 10 mail\n",
+			Error:   "unbalanced parentheses",
+		},
+		{
+			Name:    "unsupported directive",
+			Content: "$INCLUDE other.zone\n",
+			Error:   "directive is not supported",
+		},
+		{
+			Name:    "unsupported class",
+			Content: "a CH A 192.0.2.1\n",
+			Error:   "only the `IN` class is supported",
+		},
+		{
+			Name:    "unsupported type",
+			Content: "a IN HINFO \"cpu\" \"os\"\n",
+			Error:   "not supported",
+		},
+		{
+			Name:    "out of zone",
+			Content: "www.example.net. A 192.0.2.1\n",
+			Error:   "is not within the zone",
+		},
+		{
+			Name:    "invalid ipv4",
+			Content: "a A 2001:db8::1\n",
+			Error:   "is not a valid IPv4 address",
+		},
+		{
+			Name:    "invalid ipv6",
+			Content: "a AAAA 192.0.2.1\n",
+			Error:   "is not a valid IPv6 address",
+		},
+		{
+			Name:    "invalid mx preference",
+			Content: "a MX 70000 mail\n",
+			Error:   "preference",
+		},
+		{
+			Name:    "cname with other data",
+			Content: "a CNAME b\na A 192.0.2.1\n",
+			Error:   "cannot coexist",
+		},
+		{
+			Name:    "multiple cnames",
+			Content: "a CNAME b\na CNAME c\n",
+			Error:   "only a single CNAME record",
+		},
+		{
+			Name:    "differing ttls within a record set",
+			Content: "a 60 A 192.0.2.1\na 120 A 192.0.2.2\n",
+			Error:   "differs from the TTL of 60",
+		},
+		{
+			Name:    "differing inherited ttl within a record set",
+			Content: "$TTL 1h\n@ 300 MX 10 mail\n@ MX 20 backup\n",
+			Error:   "differs from the TTL of 300",
+		},
+		{
+			Name:    "missing owner",
+			Content: "  A 192.0.2.1\n",
+			Error:   "no owner name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := parseZoneFile(tc.Content, "example.com")
+			if err == nil {
+				t.Fatalf("expected an error containing %q but didn't get one", tc.Error)
+			}
+			if !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("expected an error containing %q but got %q", tc.Error, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected int64
+		Valid    bool
+	}{
+		{Input: "300", Expected: 300, Valid: true},
+		{Input: "1h", Expected: 3600, Valid: true},
+		{Input: "1H30M", Expected: 5400, Valid: true},
+		{Input: "1w2d", Expected: 777600, Valid: true},
+		{Input: "10s", Expected: 10, Valid: true},
+		{Input: "", Valid: false},
+		{Input: "h", Valid: false},
+		{Input: "1x", Valid: false},
+		{Input: "1h30", Valid: false},
+		{Input: "2147483648", Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			actual, err := parseZoneFileTTL(tc.Input)
+			if (err == nil) != tc.Valid {
+				t.Fatalf("expected valid to be %t for %q but got error %+v", tc.Valid, tc.Input, err)
+			}
+			if tc.Valid && actual != tc.Expected {
+				t.Fatalf("expected %d for %q but got %d", tc.Expected, tc.Input, actual)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFileRoundTrip(t *testing.T) {
+	expected, err := parseZoneFile(testZoneFileContent, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	recordSets := make([]zoneFileRecordSet, 0)
+	for _, v := range expected {
+		recordSets = append(recordSets, v)
+	}
+
+	rendered := renderZoneFile("example.com", recordSets)
+	if !strings.HasPrefix(rendered, "$ORIGIN example.com.\n@\t") {
+		t.Fatalf("expected the rendered zone file to start with the origin and apex records but got:\n%s", rendered)
+	}
+
+	actual, err := parseZoneFile(rendered, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error parsing rendered zone file: %+v\n%s", err, rendered)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected:\n%+v\n\ngot:\n%+v", expected, actual)
+	}
+}
+
+func TestZoneFileRecordSetExpandFlatten(t *testing.T) {
+	recordSets, err := parseZoneFile(testZoneFileContent, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	for _, key := range sortedZoneFileRecordSetKeys(recordSets) {
+		t.Run(key, func(t *testing.T) {
+			expected := recordSets[key]
+			props, err := expandZoneFileRecordSetProperties(expected)
+			if err != nil {
+				t.Fatalf("expanding: %+v", err)
+			}
+
+			actual := flattenZoneFileRecordSet(recordsets.RecordSet{
+				Name:       utils.String(expected.Name),
+				Type:       utils.String("Microsoft.Network/dnszones/" + string(expected.Type)),
+				Properties: props,
+			})
+			if actual == nil {
+				t.Fatalf("expected a record set but got nil")
+			}
+			if !reflect.DeepEqual(*actual, expected) {
+				t.Fatalf("expected:\n%+v\n\ngot:\n%+v", expected, *actual)
+			}
+		})
+	}
+}
+
+func TestFlattenZoneFileRecordSetSkipsAliasRecords(t *testing.T) {
+	actual := flattenZoneFileRecordSet(recordsets.RecordSet{
+		Name: utils.String("www"),
+		Type: utils.String("Microsoft.Network/dnszones/A"),
+		Properties: &recordsets.RecordSetProperties{
+			TTL: utils.Int64(300),
+			TargetResource: &recordsets.SubResource{
+				Id: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"),
+			},
+		},
+	})
+	if actual != nil {
+		t.Fatalf("expected alias record sets to be skipped but got %+v", *actual)
+	}
+}
@@ -0,0 +1,164 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceFirewallPolicyApplicationRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyApplicationRuleCollectionCreateUpdate,
+		Read:   resourceFirewallPolicyApplicationRuleCollectionRead,
+		Update: resourceFirewallPolicyApplicationRuleCollectionCreateUpdate,
+		Delete: resourceFirewallPolicyApplicationRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+				}, false),
+			},
+
+			"rule": firewallPolicyApplicationRuleSchema(),
+		},
+	}
+}
+
+func resourceFirewallPolicyApplicationRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleCollectionID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("name").(string))
+
+	collection := network.FirewallPolicyFilterRuleCollection{
+		RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
+		Name:               utils.String(id.RuleCollectionName),
+		Priority:           utils.Int32(int32(d.Get("priority").(int))),
+		Action: &network.FirewallPolicyFilterRuleCollectionAction{
+			Type: network.FirewallPolicyFilterRuleCollectionActionType(d.Get("action").(string)),
+		},
+		Rules: expandFirewallPolicyRuleApplication(d.Get("rule").([]interface{})),
+	}
+
+	if err := createUpdateFirewallPolicyRuleCollection(ctx, client, id, collection, d.IsNewResource(), "azurerm_firewall_policy_application_rule_collection"); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceFirewallPolicyApplicationRuleCollectionRead(d, meta)
+}
+
+func resourceFirewallPolicyApplicationRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := getFirewallPolicyRuleCollection(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	collection, ok := existing.AsFirewallPolicyFilterRuleCollection()
+	if !ok {
+		return fmt.Errorf("%s is not an Application Rule Collection", *id)
+	}
+
+	d.Set("name", id.RuleCollectionName)
+	d.Set("rule_collection_group_id", parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName).ID())
+
+	priority := 0
+	if collection.Priority != nil {
+		priority = int(*collection.Priority)
+	}
+	d.Set("priority", priority)
+
+	action := ""
+	if collection.Action != nil {
+		action = string(collection.Action.Type)
+	}
+	d.Set("action", action)
+
+	rules, err := flattenFirewallPolicyRuleApplication(collection.Rules)
+	if err != nil {
+		return fmt.Errorf("flattening `rule`: %+v", err)
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyApplicationRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return deleteFirewallPolicyRuleCollection(ctx, client, *id)
+}
@@ -0,0 +1,226 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyApplicationRuleCollectionResource struct{}
+
+func TestAccFirewallPolicyApplicationRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+	secondResourceName := "azurerm_firewall_policy_application_rule_collection.second"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_network_rule_collection.test").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing one of the Rule Collections shouldn't affect the others
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyApplicationRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleCollectionExists(ctx, clients, state)
+}
+
+func (FirewallPolicyApplicationRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "test" {
+  name                     = "acctest-app-rc-%d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 500
+  action                   = "Deny"
+
+  rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Http"
+      port = 80
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+`, FirewallPolicyRuleCollectionGroupResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (FirewallPolicyApplicationRuleCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "test" {
+  name                     = "acctest-app-rc-%d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 600
+  action                   = "Allow"
+
+  rule {
+    name        = "app_rule_collection1_rule1"
+    description = "description"
+    protocols {
+      type = "Http"
+      port = 80
+    }
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1", "10.0.0.2"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+
+  rule {
+    name = "app_rule_collection1_rule2"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses      = ["10.0.0.0/24"]
+    destination_fqdn_tags = ["WindowsDiagnostics"]
+  }
+}
+`, FirewallPolicyRuleCollectionGroupResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "second" {
+  name                     = "acctest-app-rc-second-%[2]d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 510
+  action                   = "Allow"
+
+  rule {
+    name = "app_rule_collection2_rule1"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["terraform.io"]
+  }
+}
+
+resource "azurerm_firewall_policy_network_rule_collection" "test" {
+  name                     = "acctest-net-rc-%[2]d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 400
+  action                   = "Deny"
+
+  rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1"]
+    destination_ports     = ["80"]
+  }
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "import" {
+  name                     = azurerm_firewall_policy_application_rule_collection.test.name
+  rule_collection_group_id = azurerm_firewall_policy_application_rule_collection.test.rule_collection_group_id
+  priority                 = azurerm_firewall_policy_application_rule_collection.test.priority
+  action                   = azurerm_firewall_policy_application_rule_collection.test.action
+
+  rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Http"
+      port = 80
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+`, r.basic(data))
+}
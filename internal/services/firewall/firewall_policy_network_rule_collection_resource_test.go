@@ -0,0 +1,156 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNetworkRuleCollectionResource struct{}
+
+func TestAccFirewallPolicyNetworkRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule_collection", "test")
+	r := FirewallPolicyNetworkRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule_collection", "test")
+	r := FirewallPolicyNetworkRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule_collection", "test")
+	r := FirewallPolicyNetworkRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyNetworkRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleCollectionExists(ctx, clients, state)
+}
+
+func (FirewallPolicyNetworkRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule_collection" "test" {
+  name                     = "acctest-net-rc-%d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 400
+  action                   = "Deny"
+
+  rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1", "192.168.1.2"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+`, FirewallPolicyRuleCollectionGroupResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (FirewallPolicyNetworkRuleCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ip_group" "test" {
+  name                = "acctestIpGroupForFirewallPolicy-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  cidrs               = ["192.168.0.1", "10.0.0.0/24"]
+}
+
+resource "azurerm_firewall_policy_network_rule_collection" "test" {
+  name                     = "acctest-net-rc-%[2]d"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 410
+  action                   = "Allow"
+
+  rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP"]
+    source_addresses      = ["10.0.0.0/24"]
+    destination_addresses = ["192.168.1.1"]
+    destination_ports     = ["443"]
+  }
+
+  rule {
+    name                  = "network_rule_collection1_rule2"
+    protocols             = ["Any"]
+    source_ip_groups      = [azurerm_ip_group.test.id]
+    destination_ip_groups = [azurerm_ip_group.test.id]
+    destination_ports     = ["*"]
+  }
+}
+`, FirewallPolicyRuleCollectionGroupResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyNetworkRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule_collection" "import" {
+  name                     = azurerm_firewall_policy_network_rule_collection.test.name
+  rule_collection_group_id = azurerm_firewall_policy_network_rule_collection.test.rule_collection_group_id
+  priority                 = azurerm_firewall_policy_network_rule_collection.test.priority
+  action                   = azurerm_firewall_policy_network_rule_collection.test.action
+
+  rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1", "192.168.1.2"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+`, r.basic(data))
+}
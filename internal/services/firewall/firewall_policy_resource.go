@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -48,9 +49,42 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 		},
 
 		Schema: resourceFirewallPolicySchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyCustomizeDiff),
 	}
 }
 
+func resourceFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	explicitProxy := d.Get("explicit_proxy").([]interface{})
+	if len(explicitProxy) == 0 || explicitProxy[0] == nil {
+		return nil
+	}
+
+	// the SKU defaults to `Standard` when unset, so only an explicit `Basic` SKU is rejected here
+	if sku := d.Get("sku").(string); sku == string(network.FirewallPolicySkuTierBasic) {
+		return fmt.Errorf("`explicit_proxy` can only be configured when `sku` is `Standard` or `Premium`")
+	}
+
+	raw := explicitProxy[0].(map[string]interface{})
+	if raw["enabled"].(bool) && raw["http_port"].(int) == 0 && raw["https_port"].(int) == 0 {
+		return fmt.Errorf("at least one of `http_port` or `https_port` must be specified when `explicit_proxy` is enabled")
+	}
+
+	ports := make(map[int]string)
+	for _, key := range []string{"http_port", "https_port", "pac_file_port"} {
+		port := raw[key].(int)
+		if port == 0 {
+			continue
+		}
+		if existing, ok := ports[port]; ok {
+			return fmt.Errorf("`%s` and `%s` within `explicit_proxy` cannot use the same port (%d)", existing, key, port)
+		}
+		ports[port] = key
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -78,12 +112,13 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 	props := network.FirewallPolicy{
 		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
-			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
-			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
-			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
-			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
-			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
-			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+			ThreatIntelMode:       network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+			ThreatIntelWhitelist:  expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
+			DNSSettings:           expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			IntrusionDetection:    expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			TransportSecurity:     expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
+			Insights:              expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+			ExplicitProxySettings: expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
 		},
 		Identity: expandedIdentity,
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
@@ -182,6 +217,10 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "tls_certificate": %+v`, err)
 		}
 
+		if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(prop.ExplicitProxySettings)); err != nil {
+			return fmt.Errorf(`setting "explicit_proxy": %+v`, err)
+		}
+
 		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
 			return fmt.Errorf(`setting "child_policies": %+v`, err)
 		}
@@ -340,6 +379,35 @@ func expandFirewallPolicyTransportSecurity(input []interface{}) *network.Firewal
 	}
 }
 
+func expandFirewallPolicyExplicitProxy(input []interface{}) *network.ExplicitProxySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &network.ExplicitProxySettings{
+		EnableExplicitProxy: utils.Bool(raw["enabled"].(bool)),
+	}
+
+	if v := raw["http_port"].(int); v != 0 {
+		output.HTTPPort = utils.Int32(int32(v))
+	}
+
+	if v := raw["https_port"].(int); v != 0 {
+		output.HTTPSPort = utils.Int32(int32(v))
+	}
+
+	if v := raw["pac_file_port"].(int); v != 0 {
+		output.PacFilePort = utils.Int32(int32(v))
+	}
+
+	if v := raw["pac_file"].(string); v != "" {
+		output.PacFile = utils.String(v)
+	}
+
+	return output
+}
+
 func expandFirewallPolicyIdentity(input []interface{}) (*network.ManagedServiceIdentity, error) {
 	expanded, err := identity.ExpandUserAssignedMap(input)
 	if err != nil {
@@ -544,6 +612,47 @@ func flattenFirewallPolicyTransportSecurity(input *network.FirewallPolicyTranspo
 	}
 }
 
+func flattenFirewallPolicyExplicitProxy(input *network.ExplicitProxySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.EnableExplicitProxy != nil {
+		enabled = *input.EnableExplicitProxy
+	}
+
+	httpPort := 0
+	if input.HTTPPort != nil {
+		httpPort = int(*input.HTTPPort)
+	}
+
+	httpsPort := 0
+	if input.HTTPSPort != nil {
+		httpsPort = int(*input.HTTPSPort)
+	}
+
+	pacFilePort := 0
+	if input.PacFilePort != nil {
+		pacFilePort = int(*input.PacFilePort)
+	}
+
+	pacFile := ""
+	if input.PacFile != nil {
+		pacFile = *input.PacFile
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":       enabled,
+			"http_port":     httpPort,
+			"https_port":    httpsPort,
+			"pac_file_port": pacFilePort,
+			"pac_file":      pacFile,
+		},
+	}
+}
+
 func flattenFirewallPolicyIdentity(input *network.ManagedServiceIdentity) (*[]interface{}, error) {
 	var transition *identity.UserAssignedMap
 
@@ -889,6 +998,44 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			Optional: true,
 		},
 
+		"explicit_proxy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"http_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+					},
+					"https_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+					},
+					"pac_file_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+						RequiredWith: []string{"explicit_proxy.0.pac_file"},
+					},
+					"pac_file": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						RequiredWith: []string{"explicit_proxy.0.pac_file_port"},
+					},
+				},
+			},
+		},
+
 		"child_policies": {
 			Type:     pluginsdk.TypeList,
 			Computed: true,
@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -190,6 +191,66 @@ func TestAccFirewallPolicy_insights(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_explicitProxy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.explicitProxy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.explicitProxyPacFile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicy_explicitProxyBasicSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.explicitProxyBasicSku(data),
+			ExpectError: regexp.MustCompile("`explicit_proxy` can only be configured when `sku` is `Standard` or `Premium`"),
+		},
+	})
+}
+
+func TestAccFirewallPolicy_explicitProxyPortCollision(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.explicitProxyPortCollision(data),
+			ExpectError: regexp.MustCompile("cannot use the same port"),
+		},
+	})
+}
+
 func (FirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FirewallPolicyID(state.ID)
 	if err != nil {
@@ -231,6 +292,126 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) explicitProxy(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+
+  explicit_proxy {
+    enabled    = true
+    http_port  = 8087
+    https_port = 8088
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (FirewallPolicyResource) explicitProxyPacFile(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "pacfiles"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "proxy.pac"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "function FindProxyForURL(url, host) { return \"DIRECT\"; }"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2022-01-01"
+  expiry = "2032-01-01"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+    list   = false
+  }
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+
+  explicit_proxy {
+    enabled       = true
+    http_port     = 8087
+    https_port    = 8088
+    pac_file_port = 8089
+    pac_file      = "${azurerm_storage_blob.test.url}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  }
+}
+`, template, data.RandomString, data.RandomInteger)
+}
+
+func (FirewallPolicyResource) explicitProxyBasicSku(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+
+  explicit_proxy {
+    enabled   = true
+    http_port = 8087
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (FirewallPolicyResource) explicitProxyPortCollision(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  explicit_proxy {
+    enabled    = true
+    http_port  = 8087
+    https_port = 8087
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) complete(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.template(data)
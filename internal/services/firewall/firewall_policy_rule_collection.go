@@ -0,0 +1,154 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the `azurerm_firewall_policy_*_rule_collection` resources each manage a single Rule Collection within a Firewall
+// Policy Rule Collection Group - since the API only exposes the Rule Collection Group these perform a read-modify-write
+// of the Rule Collection Group, preserving the Rule Collections which are managed elsewhere
+
+func createUpdateFirewallPolicyRuleCollection(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionId, collection network.BasicFirewallPolicyRuleCollection, isNewResource bool, resourceType string) error {
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	locks.ByID(groupId.ID())
+	defer locks.UnlockByID(groupId.ID())
+
+	// the Firewall Policy only allows a single Rule Collection Group to be updated at once
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return fmt.Errorf("%s was not found", groupId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", groupId)
+	}
+
+	collections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	exists := false
+	if group.RuleCollections != nil {
+		for _, existing := range *group.RuleCollections {
+			if strings.EqualFold(firewallPolicyRuleCollectionName(existing), id.RuleCollectionName) {
+				exists = true
+				continue
+			}
+			collections = append(collections, existing)
+		}
+	}
+
+	if isNewResource && exists {
+		return tf.ImportAsExistsError(resourceType, id.ID())
+	}
+	if !isNewResource && !exists {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	collections = append(collections, collection)
+	group.RuleCollections = &collections
+
+	if err := updateFirewallPolicyRuleCollectionGroup(ctx, client, groupId, group); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// getFirewallPolicyRuleCollection returns the Rule Collection referenced by the ID - or nil if either the Rule
+// Collection Group or the Rule Collection no longer exists
+func getFirewallPolicyRuleCollection(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionId) (network.BasicFirewallPolicyRuleCollection, error) {
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving Rule Collection Group for %s: %+v", id, err)
+	}
+
+	if props := group.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+		for _, v := range *props.RuleCollections {
+			if strings.EqualFold(firewallPolicyRuleCollectionName(v), id.RuleCollectionName) {
+				return v, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func deleteFirewallPolicyRuleCollection(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionId) error {
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	locks.ByID(groupId.ID())
+	defer locks.UnlockByID(groupId.ID())
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil || group.RuleCollections == nil {
+		return nil
+	}
+
+	collections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	for _, existing := range *group.RuleCollections {
+		if strings.EqualFold(firewallPolicyRuleCollectionName(existing), id.RuleCollectionName) {
+			continue
+		}
+		collections = append(collections, existing)
+	}
+	group.RuleCollections = &collections
+
+	if err := updateFirewallPolicyRuleCollectionGroup(ctx, client, groupId, group); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func updateFirewallPolicyRuleCollectionGroup(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId, group network.FirewallPolicyRuleCollectionGroup) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, group)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func firewallPolicyRuleCollectionName(input network.BasicFirewallPolicyRuleCollection) string {
+	var name *string
+	if v, ok := input.AsFirewallPolicyFilterRuleCollection(); ok {
+		name = v.Name
+	} else if v, ok := input.AsFirewallPolicyNatRuleCollection(); ok {
+		name = v.Name
+	} else if v, ok := input.AsFirewallPolicyRuleCollection(); ok {
+		name = v.Name
+	}
+
+	if name == nil {
+		return ""
+	}
+	return *name
+}
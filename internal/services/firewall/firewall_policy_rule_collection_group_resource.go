@@ -84,114 +84,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"type": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
-													}, false),
-												},
-												"port": {
-													Type:         pluginsdk.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(0, 64000),
-												},
-											},
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_urls": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdn_tags": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"terminate_tls": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"web_categories": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyApplicationRuleSchema(),
 					},
 				},
 			},
@@ -220,89 +113,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolAny),
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-												string(network.FirewallPolicyRuleNetworkProtocolICMP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											// Can be IP address, CIDR, "*", or service tag
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												azValidate.PortOrPortRangeWithin(1, 65535),
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyNetworkRuleSchema(),
 					},
 				},
 			},
@@ -332,82 +143,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								"Dnat",
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_address": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										ValidateFunc: validation.Any(
-											validation.IsIPAddress,
-											validation.IsCIDR,
-										),
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
-										},
-									},
-									"translated_address": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsIPAddress,
-									},
-									"translated_port": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IsPortNumber,
-									},
-									"translated_fqdn": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyNatRuleSchema(),
 					},
 				},
 			},
@@ -946,3 +682,279 @@ func flattenFirewallPolicyRuleNat(input *[]network.BasicFirewallPolicyRule) ([]i
 	}
 	return output, nil
 }
+
+func firewallPolicyApplicationRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"description": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"type": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+								}, false),
+							},
+							"port": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 64000),
+							},
+						},
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdn_tags": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"terminate_tls": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"web_categories": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNetworkRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolAny),
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+							string(network.FirewallPolicyRuleNetworkProtocolICMP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						// Can be IP address, CIDR, "*", or service tag
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ip_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							azValidate.PortOrPortRangeWithin(1, 65535),
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNatRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_address": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+					),
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
+					},
+				},
+				"translated_address": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				"translated_port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"translated_fqdn": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
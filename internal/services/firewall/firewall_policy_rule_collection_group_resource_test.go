@@ -878,3 +878,59 @@ resource "azurerm_firewall_policy_rule_collection_group" "import" {
 }
 `, template)
 }
+
+// childResourceTemplate provisions an empty Rule Collection Group whose Rule Collections are managed by the
+// `azurerm_firewall_policy_*_rule_collection` resources
+func (FirewallPolicyRuleCollectionGroupResource) childResourceTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RC-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  lifecycle {
+    ignore_changes = [application_rule_collection, network_rule_collection, nat_rule_collection]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func firewallPolicyRuleCollectionExists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupClient.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Rule Collection Group for %s: %v", id.String(), err)
+	}
+
+	if props := resp.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+		for _, v := range *props.RuleCollections {
+			if collection, ok := v.AsFirewallPolicyFilterRuleCollection(); ok && collection.Name != nil && *collection.Name == id.RuleCollectionName {
+				return utils.Bool(true), nil
+			}
+			if collection, ok := v.AsFirewallPolicyNatRuleCollection(); ok && collection.Name != nil && *collection.Name == id.RuleCollectionName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
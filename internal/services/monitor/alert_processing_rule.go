@@ -0,0 +1,634 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertProcessingRuleConditionModel struct {
+	AlertContext        []AlertProcessingRuleSingleConditionModel `tfschema:"alert_context"`
+	AlertRuleId         []AlertProcessingRuleSingleConditionModel `tfschema:"alert_rule_id"`
+	AlertRuleName       []AlertProcessingRuleSingleConditionModel `tfschema:"alert_rule_name"`
+	Description         []AlertProcessingRuleSingleConditionModel `tfschema:"description"`
+	MonitorCondition    []AlertProcessingRuleSingleConditionModel `tfschema:"monitor_condition"`
+	MonitorService      []AlertProcessingRuleSingleConditionModel `tfschema:"monitor_service"`
+	Severity            []AlertProcessingRuleSingleConditionModel `tfschema:"severity"`
+	SignalType          []AlertProcessingRuleSingleConditionModel `tfschema:"signal_type"`
+	TargetResource      []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource"`
+	TargetResourceGroup []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource_group"`
+	TargetResourceType  []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource_type"`
+}
+
+type AlertProcessingRuleSingleConditionModel struct {
+	Operator string   `tfschema:"operator"`
+	Values   []string `tfschema:"values"`
+}
+
+type AlertProcessingRuleScheduleModel struct {
+	EffectiveFrom  string                               `tfschema:"effective_from"`
+	EffectiveUntil string                               `tfschema:"effective_until"`
+	TimeZone       string                               `tfschema:"time_zone"`
+	Recurrence     []AlertProcessingRuleRecurrenceModel `tfschema:"recurrence"`
+}
+
+type AlertProcessingRuleRecurrenceModel struct {
+	Daily   []AlertProcessingRuleDailyModel   `tfschema:"daily"`
+	Weekly  []AlertProcessingRuleWeeklyModel  `tfschema:"weekly"`
+	Monthly []AlertProcessingRuleMonthlyModel `tfschema:"monthly"`
+}
+
+type AlertProcessingRuleDailyModel struct {
+	StartTime string `tfschema:"start_time"`
+	EndTime   string `tfschema:"end_time"`
+}
+
+type AlertProcessingRuleWeeklyModel struct {
+	DaysOfWeek []string `tfschema:"days_of_week"`
+	StartTime  string   `tfschema:"start_time"`
+	EndTime    string   `tfschema:"end_time"`
+}
+
+type AlertProcessingRuleMonthlyModel struct {
+	DaysOfMonth []int  `tfschema:"days_of_month"`
+	StartTime   string `tfschema:"start_time"`
+	EndTime     string `tfschema:"end_time"`
+}
+
+type alertProcessingRuleBaseResource struct{}
+
+func (r alertProcessingRuleBaseResource) arguments(fields map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([a-zA-Z\d])[a-zA-Z\d-_]*$`), "name should begin with a letter or number, contain only letters, numbers, underscores and hyphens."),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alert_context": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"alert_rule_id": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"alert_rule_name": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"description": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"monitor_condition": schemaAlertProcessingRuleCondition(
+						alertProcessingRuleEqualityOperators(),
+						[]string{
+							"Fired",
+							"Resolved",
+						},
+					),
+
+					"monitor_service": schemaAlertProcessingRuleCondition(
+						alertProcessingRuleEqualityOperators(),
+						[]string{
+							"ActivityLog Administrative",
+							"ActivityLog Autoscale",
+							"ActivityLog Policy",
+							"ActivityLog Recommendation",
+							"ActivityLog Security",
+							"Application Insights",
+							"Azure Backup",
+							"Azure Stack Edge",
+							"Azure Stack Hub",
+							"Custom",
+							"Data Box Gateway",
+							"Health Platform",
+							"Log Alerts V2",
+							"Log Analytics",
+							"Platform",
+							"Prometheus",
+							"Resource Health",
+							"Smart Detector",
+							"VM Insights - Health",
+						},
+					),
+
+					"severity": schemaAlertProcessingRuleCondition(
+						alertProcessingRuleEqualityOperators(),
+						[]string{
+							"Sev0",
+							"Sev1",
+							"Sev2",
+							"Sev3",
+							"Sev4",
+						},
+					),
+
+					"signal_type": schemaAlertProcessingRuleCondition(
+						alertProcessingRuleEqualityOperators(),
+						[]string{
+							"Metric",
+							"Log",
+							"Unknown",
+							"Health",
+						},
+					),
+
+					"target_resource": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"target_resource_group": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+
+					"target_resource_type": schemaAlertProcessingRuleCondition(alertProcessingRuleAllOperators(), nil),
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"effective_from": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.AlertProcessingRuleScheduleDateTime,
+					},
+
+					"effective_until": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.AlertProcessingRuleScheduleDateTime,
+					},
+
+					"time_zone": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "UTC",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"recurrence": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"daily": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"start_time": schemaAlertProcessingRuleRecurrenceTime(true),
+
+											"end_time": schemaAlertProcessingRuleRecurrenceTime(true),
+										},
+									},
+									AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								},
+
+								"weekly": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"days_of_week": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												MinItems: 1,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeString,
+													ValidateFunc: validation.StringInSlice(alertprocessingrules.PossibleValuesForDaysOfWeek(), false),
+												},
+											},
+
+											"start_time": schemaAlertProcessingRuleRecurrenceTime(false),
+
+											"end_time": schemaAlertProcessingRuleRecurrenceTime(false),
+										},
+									},
+									AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								},
+
+								"monthly": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"days_of_month": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												MinItems: 1,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeInt,
+													ValidateFunc: validation.IntBetween(1, 31),
+												},
+											},
+
+											"start_time": schemaAlertProcessingRuleRecurrenceTime(false),
+
+											"end_time": schemaAlertProcessingRuleRecurrenceTime(false),
+										},
+									},
+									AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+
+	for k, v := range fields {
+		output[k] = v
+	}
+
+	return output
+}
+
+func (r alertProcessingRuleBaseResource) attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r alertProcessingRuleBaseResource) deleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// importerFunc ensures the Alert Processing Rule being imported uses the expected type of action, since
+// both the Action Group and Suppression resources share the same Resource ID format
+func (r alertProcessingRuleBaseResource) importerFunc(expectedActionType alertprocessingrules.ActionType) sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Monitor.AlertProcessingRulesClient
+		id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetByName(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
+
+		for _, action := range resp.Model.Properties.Actions {
+			actionType := alertProcessingRuleActionType(action)
+			if actionType != expectedActionType {
+				return fmt.Errorf("importing %s: expected an action of type %q but got %q", *id, string(expectedActionType), string(actionType))
+			}
+		}
+
+		return nil
+	}
+}
+
+func alertProcessingRuleAllOperators() []string {
+	return alertprocessingrules.PossibleValuesForOperator()
+}
+
+func alertProcessingRuleEqualityOperators() []string {
+	return []string{
+		string(alertprocessingrules.OperatorEquals),
+		string(alertprocessingrules.OperatorNotEquals),
+	}
+}
+
+func schemaAlertProcessingRuleCondition(operators []string, values []string) *pluginsdk.Schema {
+	valuesValidation := validation.StringIsNotEmpty
+	if values != nil {
+		valuesValidation = validation.StringInSlice(values, false)
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"operator": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(operators, false),
+				},
+
+				"values": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: valuesValidation,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaAlertProcessingRuleRecurrenceTime(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     required,
+		Optional:     !required,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`), "time should be in the format `HH:MM:SS`"),
+	}
+}
+
+func alertProcessingRuleActionType(input alertprocessingrules.Action) alertprocessingrules.ActionType {
+	switch input.(type) {
+	case alertprocessingrules.AddActionGroups:
+		return alertprocessingrules.ActionTypeAddActionGroups
+	case alertprocessingrules.RemoveAllActionGroups:
+		return alertprocessingrules.ActionTypeRemoveAllActionGroups
+	}
+	return ""
+}
+
+func expandAlertProcessingRuleConditions(input []AlertProcessingRuleConditionModel) *[]alertprocessingrules.Condition {
+	conditions := make([]alertprocessingrules.Condition, 0)
+	if len(input) == 0 {
+		return &conditions
+	}
+
+	v := input[0]
+	blocks := []struct {
+		field     alertprocessingrules.Field
+		condition []AlertProcessingRuleSingleConditionModel
+	}{
+		{alertprocessingrules.FieldAlertContext, v.AlertContext},
+		{alertprocessingrules.FieldAlertRuleId, v.AlertRuleId},
+		{alertprocessingrules.FieldAlertRuleName, v.AlertRuleName},
+		{alertprocessingrules.FieldDescription, v.Description},
+		{alertprocessingrules.FieldMonitorCondition, v.MonitorCondition},
+		{alertprocessingrules.FieldMonitorService, v.MonitorService},
+		{alertprocessingrules.FieldSeverity, v.Severity},
+		{alertprocessingrules.FieldSignalType, v.SignalType},
+		{alertprocessingrules.FieldTargetResource, v.TargetResource},
+		{alertprocessingrules.FieldTargetResourceGroup, v.TargetResourceGroup},
+		{alertprocessingrules.FieldTargetResourceType, v.TargetResourceType},
+	}
+
+	for _, block := range blocks {
+		if len(block.condition) == 0 {
+			continue
+		}
+
+		field := block.field
+		operator := alertprocessingrules.Operator(block.condition[0].Operator)
+		values := block.condition[0].Values
+		conditions = append(conditions, alertprocessingrules.Condition{
+			Field:    &field,
+			Operator: &operator,
+			Values:   &values,
+		})
+	}
+
+	return &conditions
+}
+
+func flattenAlertProcessingRuleConditions(input *[]alertprocessingrules.Condition) []AlertProcessingRuleConditionModel {
+	if input == nil || len(*input) == 0 {
+		return []AlertProcessingRuleConditionModel{}
+	}
+
+	output := AlertProcessingRuleConditionModel{}
+	for _, item := range *input {
+		if item.Field == nil {
+			continue
+		}
+
+		condition := AlertProcessingRuleSingleConditionModel{}
+		if item.Operator != nil {
+			condition.Operator = string(*item.Operator)
+		}
+		if item.Values != nil {
+			condition.Values = *item.Values
+		}
+		block := []AlertProcessingRuleSingleConditionModel{condition}
+
+		switch *item.Field {
+		case alertprocessingrules.FieldAlertContext:
+			output.AlertContext = block
+		case alertprocessingrules.FieldAlertRuleId:
+			output.AlertRuleId = block
+		case alertprocessingrules.FieldAlertRuleName:
+			output.AlertRuleName = block
+		case alertprocessingrules.FieldDescription:
+			output.Description = block
+		case alertprocessingrules.FieldMonitorCondition:
+			output.MonitorCondition = block
+		case alertprocessingrules.FieldMonitorService:
+			output.MonitorService = block
+		case alertprocessingrules.FieldSeverity:
+			output.Severity = block
+		case alertprocessingrules.FieldSignalType:
+			output.SignalType = block
+		case alertprocessingrules.FieldTargetResource:
+			output.TargetResource = block
+		case alertprocessingrules.FieldTargetResourceGroup:
+			output.TargetResourceGroup = block
+		case alertprocessingrules.FieldTargetResourceType:
+			output.TargetResourceType = block
+		}
+	}
+
+	return []AlertProcessingRuleConditionModel{output}
+}
+
+func expandAlertProcessingRuleSchedule(input []AlertProcessingRuleScheduleModel) *alertprocessingrules.Schedule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	schedule := alertprocessingrules.Schedule{
+		TimeZone: utils.String(v.TimeZone),
+	}
+
+	if v.EffectiveFrom != "" {
+		schedule.EffectiveFrom = utils.String(v.EffectiveFrom)
+	}
+
+	if v.EffectiveUntil != "" {
+		schedule.EffectiveUntil = utils.String(v.EffectiveUntil)
+	}
+
+	if len(v.Recurrence) > 0 {
+		schedule.Recurrences = expandAlertProcessingRuleRecurrences(v.Recurrence[0])
+	}
+
+	return &schedule
+}
+
+func expandAlertProcessingRuleRecurrences(input AlertProcessingRuleRecurrenceModel) *[]alertprocessingrules.Recurrence {
+	recurrences := make([]alertprocessingrules.Recurrence, 0)
+
+	for _, v := range input.Daily {
+		recurrences = append(recurrences, alertprocessingrules.DailyRecurrence{
+			StartTime: utils.String(v.StartTime),
+			EndTime:   utils.String(v.EndTime),
+		})
+	}
+
+	for _, v := range input.Weekly {
+		daysOfWeek := make([]alertprocessingrules.DaysOfWeek, 0)
+		for _, day := range v.DaysOfWeek {
+			daysOfWeek = append(daysOfWeek, alertprocessingrules.DaysOfWeek(day))
+		}
+
+		recurrence := alertprocessingrules.WeeklyRecurrence{
+			DaysOfWeek: daysOfWeek,
+		}
+		if v.StartTime != "" {
+			recurrence.StartTime = utils.String(v.StartTime)
+		}
+		if v.EndTime != "" {
+			recurrence.EndTime = utils.String(v.EndTime)
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+
+	for _, v := range input.Monthly {
+		daysOfMonth := make([]int64, 0)
+		for _, day := range v.DaysOfMonth {
+			daysOfMonth = append(daysOfMonth, int64(day))
+		}
+
+		recurrence := alertprocessingrules.MonthlyRecurrence{
+			DaysOfMonth: daysOfMonth,
+		}
+		if v.StartTime != "" {
+			recurrence.StartTime = utils.String(v.StartTime)
+		}
+		if v.EndTime != "" {
+			recurrence.EndTime = utils.String(v.EndTime)
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+
+	return &recurrences
+}
+
+func flattenAlertProcessingRuleSchedule(input *alertprocessingrules.Schedule) []AlertProcessingRuleScheduleModel {
+	if input == nil {
+		return []AlertProcessingRuleScheduleModel{}
+	}
+
+	output := AlertProcessingRuleScheduleModel{
+		TimeZone: utils.NormalizeNilableString(input.TimeZone),
+	}
+
+	if input.EffectiveFrom != nil {
+		output.EffectiveFrom = *input.EffectiveFrom
+	}
+
+	if input.EffectiveUntil != nil {
+		output.EffectiveUntil = *input.EffectiveUntil
+	}
+
+	if input.Recurrences != nil && len(*input.Recurrences) > 0 {
+		output.Recurrence = []AlertProcessingRuleRecurrenceModel{flattenAlertProcessingRuleRecurrences(*input.Recurrences)}
+	}
+
+	return []AlertProcessingRuleScheduleModel{output}
+}
+
+func flattenAlertProcessingRuleRecurrences(input []alertprocessingrules.Recurrence) AlertProcessingRuleRecurrenceModel {
+	output := AlertProcessingRuleRecurrenceModel{
+		Daily:   []AlertProcessingRuleDailyModel{},
+		Weekly:  []AlertProcessingRuleWeeklyModel{},
+		Monthly: []AlertProcessingRuleMonthlyModel{},
+	}
+
+	for _, item := range input {
+		switch v := item.(type) {
+		case alertprocessingrules.DailyRecurrence:
+			output.Daily = append(output.Daily, AlertProcessingRuleDailyModel{
+				StartTime: utils.NormalizeNilableString(v.StartTime),
+				EndTime:   utils.NormalizeNilableString(v.EndTime),
+			})
+
+		case alertprocessingrules.WeeklyRecurrence:
+			daysOfWeek := make([]string, 0)
+			for _, day := range v.DaysOfWeek {
+				daysOfWeek = append(daysOfWeek, string(day))
+			}
+			output.Weekly = append(output.Weekly, AlertProcessingRuleWeeklyModel{
+				DaysOfWeek: daysOfWeek,
+				StartTime:  utils.NormalizeNilableString(v.StartTime),
+				EndTime:    utils.NormalizeNilableString(v.EndTime),
+			})
+
+		case alertprocessingrules.MonthlyRecurrence:
+			daysOfMonth := make([]int, 0)
+			for _, day := range v.DaysOfMonth {
+				daysOfMonth = append(daysOfMonth, int(day))
+			}
+			output.Monthly = append(output.Monthly, AlertProcessingRuleMonthlyModel{
+				DaysOfMonth: daysOfMonth,
+				StartTime:   utils.NormalizeNilableString(v.StartTime),
+				EndTime:     utils.NormalizeNilableString(v.EndTime),
+			})
+		}
+	}
+
+	return output
+}
@@ -26,6 +26,8 @@ func resourceMonitorActionRuleActionGroup() *pluginsdk.Resource {
 		Update: resourceMonitorActionRuleActionGroupCreateUpdate,
 		Delete: resourceMonitorActionRuleActionGroupDelete,
 
+		DeprecationMessage: "The `azurerm_monitor_action_rule_action_group` resource is deprecated and will be removed in version 4.0 of the AzureRM provider. Please use the `azurerm_monitor_alert_processing_rule_action_group` resource instead.",
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -0,0 +1,241 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertProcessingRuleActionGroupModel struct {
+	Name              string                              `tfschema:"name"`
+	ResourceGroupName string                              `tfschema:"resource_group_name"`
+	AddActionGroupIds []string                            `tfschema:"add_action_group_ids"`
+	Scopes            []string                            `tfschema:"scopes"`
+	Conditions        []AlertProcessingRuleConditionModel `tfschema:"condition"`
+	Description       string                              `tfschema:"description"`
+	Enabled           bool                                `tfschema:"enabled"`
+	Schedule          []AlertProcessingRuleScheduleModel  `tfschema:"schedule"`
+	Tags              map[string]string                   `tfschema:"tags"`
+}
+
+type AlertProcessingRuleActionGroupResource struct {
+	base alertProcessingRuleBaseResource
+}
+
+var (
+	_ sdk.ResourceWithUpdate         = AlertProcessingRuleActionGroupResource{}
+	_ sdk.ResourceWithCustomImporter = AlertProcessingRuleActionGroupResource{}
+)
+
+func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
+	return "azurerm_monitor_alert_processing_rule_action_group"
+}
+
+func (r AlertProcessingRuleActionGroupResource) ModelObject() interface{} {
+	return &AlertProcessingRuleActionGroupModel{}
+}
+
+func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return alertprocessingrules.ValidateActionRuleID
+}
+
+func (r AlertProcessingRuleActionGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base.arguments(map[string]*pluginsdk.Schema{
+		"add_action_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ActionGroupID,
+			},
+		},
+	})
+}
+
+func (r AlertProcessingRuleActionGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base.attributes()
+}
+
+func (r AlertProcessingRuleActionGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := alertprocessingrules.NewActionRuleID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.GetByName(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			schedule := expandAlertProcessingRuleSchedule(model.Schedule)
+
+			rule := alertprocessingrules.AlertProcessingRule{
+				// Alert Processing Rules are a global resource
+				Location: "global",
+				Properties: &alertprocessingrules.AlertProcessingRuleProperties{
+					Actions: []alertprocessingrules.Action{
+						alertprocessingrules.AddActionGroups{
+							ActionGroupIds: model.AddActionGroupIds,
+						},
+					},
+					Conditions:  expandAlertProcessingRuleConditions(model.Conditions),
+					Description: utils.String(model.Description),
+					Enabled:     utils.Bool(model.Enabled),
+					Schedule:    schedule,
+					Scopes:      model.Scopes,
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, rule); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByName(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AlertProcessingRuleActionGroupModel{
+				Name:              id.ActionRuleName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					for _, action := range props.Actions {
+						if v, ok := action.(alertprocessingrules.AddActionGroups); ok {
+							state.AddActionGroupIds = append(state.AddActionGroupIds, v.ActionGroupIds...)
+						}
+					}
+
+					state.Conditions = flattenAlertProcessingRuleConditions(props.Conditions)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Enabled = true
+					if props.Enabled != nil {
+						state.Enabled = *props.Enabled
+					}
+					state.Scopes = props.Scopes
+
+					state.Schedule = flattenAlertProcessingRuleSchedule(props.Schedule)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.GetByName(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			rule := *existing.Model
+			if metadata.ResourceData.HasChange("add_action_group_ids") {
+				rule.Properties.Actions = []alertprocessingrules.Action{
+					alertprocessingrules.AddActionGroups{
+						ActionGroupIds: model.AddActionGroupIds,
+					},
+				}
+			}
+
+			if metadata.ResourceData.HasChange("condition") {
+				rule.Properties.Conditions = expandAlertProcessingRuleConditions(model.Conditions)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				rule.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				rule.Properties.Enabled = utils.Bool(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("schedule") {
+				rule.Properties.Schedule = expandAlertProcessingRuleSchedule(model.Schedule)
+			}
+
+			if metadata.ResourceData.HasChange("scopes") {
+				rule.Properties.Scopes = model.Scopes
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				rule.Tags = &model.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, rule); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Delete() sdk.ResourceFunc {
+	return r.base.deleteFunc()
+}
+
+func (r AlertProcessingRuleActionGroupResource) CustomImporter() sdk.ResourceRunFunc {
+	return r.base.importerFunc(alertprocessingrules.ActionTypeAddActionGroups)
+}
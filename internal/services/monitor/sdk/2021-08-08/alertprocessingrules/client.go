@@ -0,0 +1,15 @@
+package alertprocessingrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AlertProcessingRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAlertProcessingRulesClientWithBaseURI(endpoint string) AlertProcessingRulesClient {
+	return AlertProcessingRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
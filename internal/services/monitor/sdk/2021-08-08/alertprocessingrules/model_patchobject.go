@@ -0,0 +1,6 @@
+package alertprocessingrules
+
+type PatchObject struct {
+	Properties *PatchProperties   `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+}
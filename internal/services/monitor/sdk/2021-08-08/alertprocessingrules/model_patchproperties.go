@@ -0,0 +1,5 @@
+package alertprocessingrules
+
+type PatchProperties struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
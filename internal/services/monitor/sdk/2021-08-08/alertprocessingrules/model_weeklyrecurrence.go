@@ -0,0 +1,40 @@
+package alertprocessingrules
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Recurrence = WeeklyRecurrence{}
+
+type WeeklyRecurrence struct {
+	DaysOfWeek []DaysOfWeek `json:"daysOfWeek"`
+	EndTime    *string      `json:"endTime,omitempty"`
+	StartTime  *string      `json:"startTime,omitempty"`
+
+	// Fields inherited from Recurrence
+}
+
+var _ json.Marshaler = WeeklyRecurrence{}
+
+func (s WeeklyRecurrence) MarshalJSON() ([]byte, error) {
+	type wrapper WeeklyRecurrence
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling WeeklyRecurrence: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling WeeklyRecurrence: %+v", err)
+	}
+	decoded["recurrenceType"] = "Weekly"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling WeeklyRecurrence: %+v", err)
+	}
+
+	return encoded, nil
+}
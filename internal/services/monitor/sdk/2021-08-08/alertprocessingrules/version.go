@@ -0,0 +1,9 @@
+package alertprocessingrules
+
+import "fmt"
+
+const defaultApiVersion = "2021-08-08"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/alertprocessingrules/%s", defaultApiVersion)
+}
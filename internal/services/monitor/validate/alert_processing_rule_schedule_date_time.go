@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"time"
+)
+
+// AlertProcessingRuleScheduleDateTime validates a date and time without an offset (e.g. `2022-01-01T01:02:03`), since the
+// time zone the schedule applies in is specified separately
+func AlertProcessingRuleScheduleDateTime(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if _, err := time.Parse("2006-01-02T15:04:05", v); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a date and time without an offset in the format `2006-01-02T15:04:05`, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestAlertProcessingRuleScheduleDateTime(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// date only
+			input:    "2022-01-01",
+			expected: false,
+		},
+		{
+			// date and time
+			input:    "2022-01-01T01:02:03",
+			expected: true,
+		},
+		{
+			// UTC
+			input:    "2022-01-01T01:02:03Z",
+			expected: false,
+		},
+		{
+			// with an offset
+			input:    "2022-01-01T01:02:03+02:00",
+			expected: false,
+		},
+		{
+			// invalid time
+			input:    "2022-01-01T25:02:03",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := AlertProcessingRuleScheduleDateTime(v.input, "effective_from")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -20,8 +20,15 @@ type Client struct {
 	InstanceFailoverGroupsClient                       *sql.InstanceFailoverGroupsClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	JobStepsClient                                     *sql.JobStepsClient
+	JobTargetGroupsClient                              *sql.JobTargetGroupsClient
+	JobsClient                                         *sql.JobsClient
 	LongTermRetentionPoliciesClient                    *sql.LongTermRetentionPoliciesClient
+	ManagedBackupShortTermRetentionPoliciesClient      *sql.ManagedBackupShortTermRetentionPoliciesClient
 	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
+	ManagedInstanceEncryptionProtectorClient           *sql.ManagedInstanceEncryptionProtectorsClient
+	ManagedInstanceKeysClient                          *sql.ManagedInstanceKeysClient
+	ManagedInstanceLongTermRetentionPoliciesClient     *sql.ManagedInstanceLongTermRetentionPoliciesClient
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
 	ManagedInstanceVulnerabilityAssessmentsClient      *sql.ManagedInstanceVulnerabilityAssessmentsClient
 	OutboundFirewallRulesClient                        *sql.OutboundFirewallRulesClient
@@ -83,12 +90,33 @@ func NewClient(o *common.ClientOptions) *Client {
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	jobStepsClient := sql.NewJobStepsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStepsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobTargetGroupsClient := sql.NewJobTargetGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobTargetGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobsClient := sql.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
 	longTermRetentionPoliciesClient := sql.NewLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&longTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
 	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedBackupShortTermRetentionPoliciesClient := sql.NewManagedBackupShortTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedBackupShortTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceEncryptionProtectorsClient := sql.NewManagedInstanceEncryptionProtectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceEncryptionProtectorsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceKeysClient := sql.NewManagedInstanceKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceKeysClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceLongTermRetentionPoliciesClient := sql.NewManagedInstanceLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceLongTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	managedInstancesClient := sql.NewManagedInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstancesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -160,9 +188,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		InstanceFailoverGroupsClient:                    &instanceFailoverGroupsClient,
 		JobAgentsClient:                                 &jobAgentsClient,
 		JobCredentialsClient:                            &jobCredentialsClient,
+		JobStepsClient:                                  &jobStepsClient,
+		JobTargetGroupsClient:                           &jobTargetGroupsClient,
+		JobsClient:                                      &jobsClient,
 		LongTermRetentionPoliciesClient:                 &longTermRetentionPoliciesClient,
 		ManagedDatabasesClient:                          &managedDatabasesClient,
+		ManagedBackupShortTermRetentionPoliciesClient:   &managedBackupShortTermRetentionPoliciesClient,
 		ManagedInstanceAdministratorsClient:             &managedInstancesAdministratorsClient,
+		ManagedInstanceEncryptionProtectorClient:        &managedInstanceEncryptionProtectorsClient,
+		ManagedInstanceKeysClient:                       &managedInstanceKeysClient,
+		ManagedInstanceLongTermRetentionPoliciesClient:  &managedInstanceLongTermRetentionPoliciesClient,
 		ManagedInstanceAzureADOnlyAuthenticationsClient: &managedInstanceAzureADOnlyAuthenticationsClient,
 		ManagedInstancesClient:                          &managedInstancesClient,
 		ManagedInstanceVulnerabilityAssessmentsClient:   &managedInstanceVulnerabilityAssessmentsClient,
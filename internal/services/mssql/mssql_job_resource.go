@@ -0,0 +1,273 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJob() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobCreateUpdate,
+		Read:   resourceMsSqlJobRead,
+		Update: resourceMsSqlJobCreateUpdate,
+		Delete: resourceMsSqlJobDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_agent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"schedule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(sql.JobScheduleTypeOnce),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobScheduleTypeOnce),
+								string(sql.JobScheduleTypeRecurring),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"interval": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+						},
+
+						"start_time": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+
+						"end_time": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Job creation.")
+
+	jaId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewJobID(jaId.SubscriptionId, jaId.ResourceGroup, jaId.ServerName, jaId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing MsSql %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job", id.ID())
+		}
+	}
+
+	schedule, err := expandMsSqlJobSchedule(d.Get("schedule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	job := sql.Job{
+		JobProperties: &sql.JobProperties{
+			Description: utils.String(d.Get("description").(string)),
+			Schedule:    schedule,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name, job); err != nil {
+		return fmt.Errorf("creating MsSql %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobRead(d, meta)
+}
+
+func resourceMsSqlJobRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading MsSql %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("job_agent_id", parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName).ID())
+
+	if props := resp.JobProperties; props != nil {
+		d.Set("description", props.Description)
+
+		if err := d.Set("schedule", flattenMsSqlJobSchedule(props.Schedule)); err != nil {
+			return fmt.Errorf("setting `schedule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name); err != nil {
+		return fmt.Errorf("deleting MsSql %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobSchedule(input []interface{}) (*sql.JobSchedule, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	scheduleType := sql.JobScheduleType(v["type"].(string))
+
+	schedule := sql.JobSchedule{
+		Type:    scheduleType,
+		Enabled: utils.Bool(v["enabled"].(bool)),
+	}
+
+	interval := v["interval"].(string)
+	if scheduleType == sql.JobScheduleTypeRecurring && interval == "" {
+		return nil, fmt.Errorf("`interval` must be specified when `type` is `%s`", scheduleType)
+	}
+	if interval != "" {
+		schedule.Interval = utils.String(interval)
+	}
+
+	if startTime := v["start_time"].(string); startTime != "" {
+		t, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start_time`: %+v", err)
+		}
+		schedule.StartTime = &date.Time{Time: t}
+	}
+
+	if endTime := v["end_time"].(string); endTime != "" {
+		t, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end_time`: %+v", err)
+		}
+		schedule.EndTime = &date.Time{Time: t}
+	}
+
+	return &schedule, nil
+}
+
+func flattenMsSqlJobSchedule(input *sql.JobSchedule) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":       string(input.Type),
+			"enabled":    utils.NormaliseNilableBool(input.Enabled),
+			"interval":   utils.NormalizeNilableString(input.Interval),
+			"start_time": startTime,
+			"end_time":   endTime,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlJobResource struct{}
+
+func TestAccMsSqlJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJob_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlJobResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-job-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestmssqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dministr4t0r"
+  administrator_login_password = "superSecur3!!!"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctestmssqldb%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  collation = "SQL_Latin1_General_CP1_CI_AS"
+  sku_name  = "S1"
+}
+
+resource "azurerm_mssql_job_agent" "test" {
+  name        = "acctestmssqljobagent%[1]d"
+  location    = azurerm_resource_group.test.location
+  database_id = azurerm_mssql_database.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MsSqlJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctestmssqljob%d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "import" {
+  name         = azurerm_mssql_job.test.name
+  job_agent_id = azurerm_mssql_job.test.job_agent_id
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctestmssqljob%d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  description  = "Acceptance Test Job"
+
+  schedule {
+    type       = "Recurring"
+    enabled    = true
+    interval   = "PT1H"
+    start_time = "2030-01-01T00:00:00Z"
+    end_time   = "2031-01-01T00:00:00Z"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
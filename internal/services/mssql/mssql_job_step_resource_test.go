@@ -0,0 +1,229 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlJobStepResource struct{}
+
+func TestAccMsSqlJobStep_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("step_index").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobStep_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobStep_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobStep_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobStepResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobStepID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobStepsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlJobStepResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-jobstep-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestmssqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dministr4t0r"
+  administrator_login_password = "superSecur3!!!"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctestmssqldb%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  collation = "SQL_Latin1_General_CP1_CI_AS"
+  sku_name  = "S1"
+}
+
+resource "azurerm_mssql_job_agent" "test" {
+  name        = "acctestmssqljobagent%[1]d"
+  location    = azurerm_resource_group.test.location
+  database_id = azurerm_mssql_database.test.id
+}
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctestmssqljobcredential%[1]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "test"
+  password     = "test"
+}
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctestmssqljobtargetgroup%[1]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+
+  job_target {
+    type          = "SqlDatabase"
+    server_name   = azurerm_mssql_server.test.name
+    database_name = azurerm_mssql_database.test.name
+  }
+}
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctestmssqljob%[1]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MsSqlJobStepResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctestmssqljobstep%d"
+  job_id              = azurerm_mssql_job.test.id
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+  job_credential_id   = azurerm_mssql_job_credential.test.id
+  sql_script          = "SELECT 1"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobStepResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "import" {
+  name                = azurerm_mssql_job_step.test.name
+  job_id              = azurerm_mssql_job_step.test.job_id
+  job_target_group_id = azurerm_mssql_job_step.test.job_target_group_id
+  job_credential_id   = azurerm_mssql_job_step.test.job_credential_id
+  sql_script          = azurerm_mssql_job_step.test.sql_script
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobStepResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctestmssqljobstep%d"
+  job_id              = azurerm_mssql_job.test.id
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+  job_credential_id   = azurerm_mssql_job_credential.test.id
+  step_index          = 1
+
+  sql_script = <<EOT
+IF NOT EXISTS (SELECT * FROM sys.objects WHERE [name] = N'Pets')
+  CREATE TABLE Pets (
+    Animal NVARCHAR(50),
+    Name NVARCHAR(50),
+  );
+EOT
+
+  timeout_seconds                   = 3600
+  retry_attempts                    = 5
+  initial_retry_interval_seconds    = 10
+  maximum_retry_interval_seconds    = 300
+  retry_interval_backoff_multiplier = 3
+
+  output_target {
+    mssql_database_id = azurerm_mssql_database.test.id
+    schema_name       = "dbo"
+    table_name        = "JobOutput"
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,295 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJobTargetGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobTargetGroupCreateUpdate,
+		Read:   resourceMsSqlJobTargetGroupRead,
+		Update: resourceMsSqlJobTargetGroupCreateUpdate,
+		Delete: resourceMsSqlJobTargetGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobTargetGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_agent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"job_target": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetTypeSQLDatabase),
+								string(sql.JobTargetTypeSQLElasticPool),
+								string(sql.JobTargetTypeSQLServer),
+								string(sql.JobTargetTypeSQLShardMap),
+							}, false),
+						},
+
+						"server_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"database_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"elastic_pool_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"shard_map_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"job_credential_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"membership_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(sql.JobTargetGroupMembershipTypeInclude),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetGroupMembershipTypeExclude),
+								string(sql.JobTargetGroupMembershipTypeInclude),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobTargetGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Job Target Group creation.")
+
+	jaId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewJobTargetGroupID(jaId.SubscriptionId, jaId.ResourceGroup, jaId.ServerName, jaId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing MsSql %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_target_group", id.ID())
+		}
+	}
+
+	targets, err := expandMsSqlJobTargets(d.Get("job_target").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	targetGroup := sql.JobTargetGroup{
+		JobTargetGroupProperties: &sql.JobTargetGroupProperties{
+			Members: targets,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName, targetGroup); err != nil {
+		return fmt.Errorf("creating MsSql %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobTargetGroupRead(d, meta)
+}
+
+func resourceMsSqlJobTargetGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading MsSql %s: %+v", id, err)
+	}
+
+	d.Set("name", id.TargetGroupName)
+	d.Set("job_agent_id", parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName).ID())
+
+	var members *[]sql.JobTarget
+	if props := resp.JobTargetGroupProperties; props != nil {
+		members = props.Members
+	}
+	if err := d.Set("job_target", flattenMsSqlJobTargets(members)); err != nil {
+		return fmt.Errorf("setting `job_target`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobTargetGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName); err != nil {
+		return fmt.Errorf("deleting MsSql %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobTargets(input []interface{}) (*[]sql.JobTarget, error) {
+	targets := make([]sql.JobTarget, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		targetType := sql.JobTargetType(v["type"].(string))
+
+		target := sql.JobTarget{
+			Type:           targetType,
+			MembershipType: sql.JobTargetGroupMembershipType(v["membership_type"].(string)),
+			ServerName:     utils.String(v["server_name"].(string)),
+		}
+
+		databaseName := v["database_name"].(string)
+		elasticPoolName := v["elastic_pool_name"].(string)
+		shardMapName := v["shard_map_name"].(string)
+
+		switch targetType {
+		case sql.JobTargetTypeSQLDatabase:
+			if databaseName == "" {
+				return nil, fmt.Errorf("`database_name` must be specified when `type` is `%s`", targetType)
+			}
+		case sql.JobTargetTypeSQLElasticPool:
+			if elasticPoolName == "" {
+				return nil, fmt.Errorf("`elastic_pool_name` must be specified when `type` is `%s`", targetType)
+			}
+		case sql.JobTargetTypeSQLShardMap:
+			if shardMapName == "" {
+				return nil, fmt.Errorf("`shard_map_name` must be specified when `type` is `%s`", targetType)
+			}
+		}
+
+		if databaseName != "" {
+			target.DatabaseName = utils.String(databaseName)
+		}
+
+		if elasticPoolName != "" {
+			target.ElasticPoolName = utils.String(elasticPoolName)
+		}
+
+		if shardMapName != "" {
+			target.ShardMapName = utils.String(shardMapName)
+		}
+
+		// the credential is used to enumerate the databases within a Server, Elastic Pool or Shard Map
+		if credentialId := v["job_credential_id"].(string); credentialId != "" {
+			if targetType == sql.JobTargetTypeSQLDatabase {
+				return nil, fmt.Errorf("`job_credential_id` cannot be specified when `type` is `%s`", targetType)
+			}
+			target.RefreshCredential = utils.String(credentialId)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return &targets, nil
+}
+
+func flattenMsSqlJobTargets(input *[]sql.JobTarget) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		credentialId := ""
+		if v.RefreshCredential != nil {
+			if id, err := parse.JobCredentialID(*v.RefreshCredential); err == nil {
+				credentialId = id.ID()
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"type":              string(v.Type),
+			"server_name":       utils.NormalizeNilableString(v.ServerName),
+			"database_name":     utils.NormalizeNilableString(v.DatabaseName),
+			"elastic_pool_name": utils.NormalizeNilableString(v.ElasticPoolName),
+			"shard_map_name":    utils.NormalizeNilableString(v.ShardMapName),
+			"job_credential_id": credentialId,
+			"membership_type":   string(v.MembershipType),
+		})
+	}
+
+	return output
+}
@@ -6,19 +6,38 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	mssqlValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type MsSqlManagedDatabaseModel struct {
-	Name              string `tfschema:"name"`
-	ManagedInstanceId string `tfschema:"managed_instance_id"`
+	Name                    string                                        `tfschema:"name"`
+	ManagedInstanceId       string                                        `tfschema:"managed_instance_id"`
+	ShortTermRetentionDays  int32                                         `tfschema:"short_term_retention_days"`
+	LongTermRetentionPolicy []MsSqlManagedDatabaseLongTermRetentionModel  `tfschema:"long_term_retention_policy"`
+	PointInTimeRestore      []MsSqlManagedDatabasePointInTimeRestoreModel `tfschema:"point_in_time_restore"`
 }
 
-var _ sdk.Resource = MsSqlManagedDatabaseResource{}
+type MsSqlManagedDatabaseLongTermRetentionModel struct {
+	WeeklyRetention  string `tfschema:"weekly_retention"`
+	MonthlyRetention string `tfschema:"monthly_retention"`
+	YearlyRetention  string `tfschema:"yearly_retention"`
+	WeekOfYear       int32  `tfschema:"week_of_year"`
+}
+
+type MsSqlManagedDatabasePointInTimeRestoreModel struct {
+	RestorePointInTime string `tfschema:"restore_point_in_time"`
+	SourceDatabaseId   string `tfschema:"source_database_id"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlManagedDatabaseResource{}
 
 type MsSqlManagedDatabaseResource struct{}
 
@@ -31,7 +50,7 @@ func (r MsSqlManagedDatabaseResource) ModelObject() interface{} {
 }
 
 func (r MsSqlManagedDatabaseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
-	return validate.ManagedDatabaseID
+	return mssqlValidate.ManagedDatabaseID
 }
 
 func (r MsSqlManagedDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
@@ -40,14 +59,84 @@ func (r MsSqlManagedDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.ValidateMsSqlDatabaseName,
+			ValidateFunc: mssqlValidate.ValidateMsSqlDatabaseName,
 		},
 
 		"managed_instance_id": {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.ManagedInstanceID,
+			ValidateFunc: mssqlValidate.ManagedInstanceID,
+		},
+
+		"short_term_retention_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(1, 35),
+		},
+
+		"long_term_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"weekly_retention": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"monthly_retention": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"yearly_retention": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"week_of_year": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(1, 52),
+					},
+				},
+			},
+		},
+
+		"point_in_time_restore": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"restore_point_in_time": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						DiffSuppressFunc: suppress.RFC3339Time,
+						ValidateFunc:     validation.IsRFC3339Time,
+					},
+
+					"source_database_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: mssqlValidate.ManagedDatabaseID,
+					},
+				},
+			},
 		},
 	}
 }
@@ -92,7 +181,21 @@ func (r MsSqlManagedDatabaseResource) Create() sdk.ResourceFunc {
 			}
 
 			parameters := sql.ManagedDatabase{
-				Location: managedInstance.Location,
+				Location:                  managedInstance.Location,
+				ManagedDatabaseProperties: &sql.ManagedDatabaseProperties{},
+			}
+
+			// the source database can live on a different Managed Instance, which allows for cross-instance restores
+			if len(model.PointInTimeRestore) > 0 {
+				restore := model.PointInTimeRestore[0]
+				restorePointInTime, err := time.Parse(time.RFC3339, restore.RestorePointInTime)
+				if err != nil {
+					return fmt.Errorf("parsing `restore_point_in_time` value %q for %s: %+v", restore.RestorePointInTime, id, err)
+				}
+
+				parameters.ManagedDatabaseProperties.CreateMode = sql.ManagedDatabaseCreateModePointInTimeRestore
+				parameters.ManagedDatabaseProperties.RestorePointInTime = &date.Time{Time: restorePointInTime}
+				parameters.ManagedDatabaseProperties.SourceDatabaseID = utils.String(restore.SourceDatabaseId)
 			}
 
 			metadata.Logger.Infof("Creating %s", id)
@@ -106,6 +209,16 @@ func (r MsSqlManagedDatabaseResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 			}
 
+			if err := r.updateShortTermRetentionPolicy(ctx, metadata, id, model.ShortTermRetentionDays); err != nil {
+				return err
+			}
+
+			if len(model.LongTermRetentionPolicy) > 0 {
+				if err := r.updateLongTermRetentionPolicy(ctx, metadata, id, model.LongTermRetentionPolicy[0]); err != nil {
+					return err
+				}
+			}
+
 			metadata.SetID(id)
 
 			return nil
@@ -113,6 +226,42 @@ func (r MsSqlManagedDatabaseResource) Create() sdk.ResourceFunc {
 	}
 }
 
+func (r MsSqlManagedDatabaseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedDatabaseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("short_term_retention_days") {
+				if err := r.updateShortTermRetentionPolicy(ctx, metadata, *id, model.ShortTermRetentionDays); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("long_term_retention_policy") {
+				policy := MsSqlManagedDatabaseLongTermRetentionModel{}
+				if len(model.LongTermRetentionPolicy) > 0 {
+					policy = model.LongTermRetentionPolicy[0]
+				}
+
+				if err := r.updateLongTermRetentionPolicy(ctx, metadata, *id, policy); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r MsSqlManagedDatabaseResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -143,7 +292,23 @@ func (r MsSqlManagedDatabaseResource) Read() sdk.ResourceFunc {
 			model := MsSqlManagedDatabaseModel{
 				Name:              id.DatabaseName,
 				ManagedInstanceId: managedInstanceId.ID(),
+				// the restore source is only used during creation and isn't returned by the API
+				PointInTimeRestore: state.PointInTimeRestore,
+			}
+
+			shortTermRetention, err := metadata.Client.MSSQL.ManagedBackupShortTermRetentionPoliciesClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName)
+			if err != nil {
+				return fmt.Errorf("retrieving Short Term Retention Policy for %s: %v", id, err)
 			}
+			if props := shortTermRetention.ManagedBackupShortTermRetentionPolicyProperties; props != nil && props.RetentionDays != nil {
+				model.ShortTermRetentionDays = *props.RetentionDays
+			}
+
+			longTermRetention, err := metadata.Client.MSSQL.ManagedInstanceLongTermRetentionPoliciesClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName)
+			if err != nil {
+				return fmt.Errorf("retrieving Long Term Retention Policy for %s: %v", id, err)
+			}
+			model.LongTermRetentionPolicy = flattenManagedDatabaseLongTermRetentionPolicy(longTermRetention.BaseLongTermRetentionPolicyProperties)
 
 			return metadata.Encode(&model)
 		},
@@ -174,3 +339,102 @@ func (r MsSqlManagedDatabaseResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r MsSqlManagedDatabaseResource) updateShortTermRetentionPolicy(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedDatabaseId, retentionDays int32) error {
+	client := metadata.Client.MSSQL.ManagedBackupShortTermRetentionPoliciesClient
+
+	parameters := sql.ManagedBackupShortTermRetentionPolicy{
+		ManagedBackupShortTermRetentionPolicyProperties: &sql.ManagedBackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(retentionDays),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("setting Short Term Retention Policy for %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Short Term Retention Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (r MsSqlManagedDatabaseResource) updateLongTermRetentionPolicy(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedDatabaseId, input MsSqlManagedDatabaseLongTermRetentionModel) error {
+	client := metadata.Client.MSSQL.ManagedInstanceLongTermRetentionPoliciesClient
+
+	parameters := sql.ManagedInstanceLongTermRetentionPolicy{
+		BaseLongTermRetentionPolicyProperties: expandManagedDatabaseLongTermRetentionPolicy(input),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("setting Long Term Retention Policy for %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Long Term Retention Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// expandManagedDatabaseLongTermRetentionPolicy defaults any retention which isn't specified to `PT0S`, which disables it
+func expandManagedDatabaseLongTermRetentionPolicy(input MsSqlManagedDatabaseLongTermRetentionModel) *sql.BaseLongTermRetentionPolicyProperties {
+	output := sql.BaseLongTermRetentionPolicyProperties{
+		WeeklyRetention:  utils.String("PT0S"),
+		MonthlyRetention: utils.String("PT0S"),
+		YearlyRetention:  utils.String("PT0S"),
+		WeekOfYear:       utils.Int32(1),
+	}
+
+	if input.WeeklyRetention != "" {
+		output.WeeklyRetention = utils.String(input.WeeklyRetention)
+	}
+
+	if input.MonthlyRetention != "" {
+		output.MonthlyRetention = utils.String(input.MonthlyRetention)
+	}
+
+	if input.YearlyRetention != "" {
+		output.YearlyRetention = utils.String(input.YearlyRetention)
+	}
+
+	if input.WeekOfYear != 0 {
+		output.WeekOfYear = utils.Int32(input.WeekOfYear)
+	}
+
+	return &output
+}
+
+func flattenManagedDatabaseLongTermRetentionPolicy(input *sql.BaseLongTermRetentionPolicyProperties) []MsSqlManagedDatabaseLongTermRetentionModel {
+	if input == nil {
+		return []MsSqlManagedDatabaseLongTermRetentionModel{}
+	}
+
+	output := MsSqlManagedDatabaseLongTermRetentionModel{
+		WeeklyRetention:  "PT0S",
+		MonthlyRetention: "PT0S",
+		YearlyRetention:  "PT0S",
+		WeekOfYear:       1,
+	}
+
+	if input.WeeklyRetention != nil {
+		output.WeeklyRetention = *input.WeeklyRetention
+	}
+
+	if input.MonthlyRetention != nil {
+		output.MonthlyRetention = *input.MonthlyRetention
+	}
+
+	if input.YearlyRetention != nil {
+		output.YearlyRetention = *input.YearlyRetention
+	}
+
+	if input.WeekOfYear != nil {
+		output.WeekOfYear = *input.WeekOfYear
+	}
+
+	return []MsSqlManagedDatabaseLongTermRetentionModel{output}
+}
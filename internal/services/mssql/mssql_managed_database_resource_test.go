@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
@@ -25,7 +26,77 @@ func TestAccMsSqlManagedDatabase_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(""),
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedDatabase_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_database", "test")
+	r := MsSqlManagedDatabase{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("short_term_retention_days").HasValue("14"),
+				check.That(data.ResourceName).Key("long_term_retention_policy.0.weekly_retention").HasValue("P1W"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedDatabase_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_database", "test")
+	r := MsSqlManagedDatabase{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("short_term_retention_days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("short_term_retention_days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedDatabase_pointInTimeRestore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_database", "test")
+	r := MsSqlManagedDatabase{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.pointInTimeRestore(data, time.Now().Add(10*time.Minute).UTC().Format(time.RFC3339)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_mssql_managed_database.restored").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepFor("azurerm_mssql_managed_database.restored", "point_in_time_restore"),
 	})
 }
 
@@ -56,3 +127,38 @@ resource "azurerm_mssql_managed_database" "test" {
 }
 `, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger)
 }
+
+func (r MsSqlManagedDatabase) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_database" "test" {
+  managed_instance_id       = azurerm_mssql_managed_instance.test.id
+  name                      = "acctest-%[2]d"
+  short_term_retention_days = 14
+
+  long_term_retention_policy {
+    weekly_retention  = "P1W"
+    monthly_retention = "P1M"
+    yearly_retention  = "P1Y"
+    week_of_year      = 1
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedDatabase) pointInTimeRestore(data acceptance.TestData, restorePointInTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_database" "restored" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  name                = "acctest-%[2]d-restored"
+
+  point_in_time_restore {
+    restore_point_in_time = "%[3]s"
+    source_database_id    = azurerm_mssql_managed_database.test.id
+  }
+}
+`, r.basic(data), data.RandomInteger, restorePointInTime)
+}
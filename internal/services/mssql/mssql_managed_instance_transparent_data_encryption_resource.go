@@ -0,0 +1,239 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceTransparentDataEncryptionModel struct {
+	ManagedInstanceId   string `tfschema:"managed_instance_id"`
+	KeyVaultKeyId       string `tfschema:"key_vault_key_id"`
+	AutoRotationEnabled bool   `tfschema:"auto_rotation_enabled"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceTransparentDataEncryptionResource{}
+
+type MsSqlManagedInstanceTransparentDataEncryptionResource struct{}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_transparent_data_encryption"
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceTransparentDataEncryptionModel{}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceEncryptionProtectorID
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"key_vault_key_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemId,
+		},
+
+		"auto_rotation_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			Default:      false,
+			RequiredWith: []string{"key_vault_key_id"},
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlManagedInstanceTransparentDataEncryptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			// the Encryption Protector always exists (using a Service Managed key by default) and is always named `current`,
+			// as such there's no requires import check here - we update whatever is there
+			id := parse.NewManagedInstanceEncryptionProtectorID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "current")
+
+			if err := r.setEncryptionProtector(ctx, metadata, id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceEncryptionProtectorClient
+
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(result.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceTransparentDataEncryptionModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			// the Key Vault Key is only returned when a Customer Managed key is in use
+			if props := result.ManagedInstanceEncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.ServerKeyTypeAzureKeyVault {
+				model.KeyVaultKeyId = utils.NormalizeNilableString(props.URI)
+				model.AutoRotationEnabled = utils.NormaliseNilableBool(props.AutoRotationEnabled)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceTransparentDataEncryptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return r.setEncryptionProtector(ctx, metadata, *id, model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Encryption Protector can't be deleted, only switched between a Customer and Service Managed key - so
+			// we revert to the latter, which prevents the Managed Instance being locked out should the key later be removed
+			return r.setEncryptionProtector(ctx, metadata, *id, MsSqlManagedInstanceTransparentDataEncryptionModel{})
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) setEncryptionProtector(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedInstanceEncryptionProtectorId, model MsSqlManagedInstanceTransparentDataEncryptionModel) error {
+	client := metadata.Client.MSSQL.ManagedInstanceEncryptionProtectorClient
+	keysClient := metadata.Client.MSSQL.ManagedInstanceKeysClient
+
+	properties := sql.ManagedInstanceEncryptionProtectorProperties{
+		ServerKeyType:       sql.ServerKeyTypeServiceManaged,
+		ServerKeyName:       utils.String("ServiceManaged"),
+		AutoRotationEnabled: utils.Bool(false),
+	}
+
+	if model.KeyVaultKeyId != "" {
+		keyName, err := managedInstanceKeyNameFromKeyVaultKeyId(model.KeyVaultKeyId)
+		if err != nil {
+			return err
+		}
+
+		key := sql.ManagedInstanceKey{
+			ManagedInstanceKeyProperties: &sql.ManagedInstanceKeyProperties{
+				ServerKeyType: sql.ServerKeyTypeAzureKeyVault,
+				URI:           utils.String(model.KeyVaultKeyId),
+			},
+		}
+
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, keyName, key)
+		if err != nil {
+			return fmt.Errorf("creating Managed Instance Key %q for %s: %+v", keyName, id, err)
+		}
+
+		if err := keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation of Managed Instance Key %q for %s: %+v", keyName, id, err)
+		}
+
+		properties.ServerKeyType = sql.ServerKeyTypeAzureKeyVault
+		properties.ServerKeyName = utils.String(keyName)
+		properties.AutoRotationEnabled = utils.Bool(model.AutoRotationEnabled)
+	}
+
+	parameters := sql.ManagedInstanceEncryptionProtector{
+		ManagedInstanceEncryptionProtectorProperties: &properties,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// managedInstanceKeyNameFromKeyVaultKeyId returns the name of the Managed Instance Key for a Key Vault Key, which is
+// required to be in the format `{vaultName}_{keyName}_{keyVersion}`
+func managedInstanceKeyNameFromKeyVaultKeyId(input string) (string, error) {
+	keyId, err := keyVaultParse.ParseNestedItemID(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing `key_vault_key_id` %q: %+v", input, err)
+	}
+
+	if keyId.NestedItemType != "keys" {
+		return "", fmt.Errorf("`key_vault_key_id` must reference a Key, but got a %q", keyId.NestedItemType)
+	}
+
+	vaultUrl, err := url.ParseRequestURI(keyId.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("parsing Key Vault URL %q: %+v", keyId.KeyVaultBaseUrl, err)
+	}
+	vaultName := strings.Split(vaultUrl.Host, ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, keyId.Name, keyId.Version), nil
+}
@@ -0,0 +1,210 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"sort"
+)
+
+// addressRange is an inclusive range of IPv4 Addresses, stored as integers so that ranges can be compared and split
+type addressRange struct {
+	first uint32
+	last  uint32
+}
+
+// parseIPv4AddressRange parses an IPv4 CIDR into the range of addresses it covers - returning nil when the CIDR
+// is a valid IPv6 CIDR, which the address planning helpers don't support
+func parseIPv4AddressRange(cidr string) (*addressRange, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a CIDR: %+v", cidr, err)
+	}
+
+	ip := network.IP.To4()
+	if ip == nil {
+		return nil, nil
+	}
+
+	ones, bits := network.Mask.Size()
+	first := ipv4ToUint32(ip)
+	size := uint64(1) << uint(bits-ones)
+	return &addressRange{
+		first: first,
+		last:  uint32(uint64(first) + size - 1),
+	}, nil
+}
+
+// availableAddressPrefixes returns the smallest set of CIDRs covering the IPv4 addresses within the address spaces
+// which aren't used by any of the used prefixes (e.g. the Subnets within a Virtual Network)
+func availableAddressPrefixes(addressSpaces []string, usedPrefixes []string) ([]string, error) {
+	free, err := freeAddressRanges(addressSpaces, usedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for _, r := range free {
+		result = append(result, addressRangeToPrefixes(r)...)
+	}
+	return result, nil
+}
+
+// nextAvailableAddressPrefix returns the lowest CIDR with the specified prefix length which fits within the address
+// spaces without overlapping any of the used prefixes
+func nextAvailableAddressPrefix(addressSpaces []string, usedPrefixes []string, prefixLength int) (string, error) {
+	if prefixLength < 0 || prefixLength > 32 {
+		return "", fmt.Errorf("the prefix length must be between 0 and 32 but got %d", prefixLength)
+	}
+
+	free, err := freeAddressRanges(addressSpaces, usedPrefixes)
+	if err != nil {
+		return "", err
+	}
+
+	size := uint64(1) << uint(32-prefixLength)
+	for _, r := range free {
+		// round the start of the range up to the next boundary for a block of this size
+		start := (uint64(r.first) + size - 1) / size * size
+		if start+size-1 <= uint64(r.last) {
+			return fmt.Sprintf("%s/%d", uint32ToIPv4(uint32(start)), prefixLength), nil
+		}
+	}
+
+	return "", fmt.Errorf("no /%d address prefix is available within the address space %v", prefixLength, addressSpaces)
+}
+
+// freeAddressRanges returns the sorted ranges of IPv4 addresses within the address spaces which aren't used by any
+// of the used prefixes - IPv6 address spaces and prefixes are ignored
+func freeAddressRanges(addressSpaces []string, usedPrefixes []string) ([]addressRange, error) {
+	spaces, err := parseIPv4AddressRanges(addressSpaces)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the address space: %+v", err)
+	}
+	used, err := parseIPv4AddressRanges(usedPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the used address prefixes: %+v", err)
+	}
+
+	used = mergeAddressRanges(used)
+
+	result := make([]addressRange, 0)
+	for _, space := range mergeAddressRanges(spaces) {
+		// `next` is a uint64 since it can move beyond the end of the IPv4 address space
+		next := uint64(space.first)
+		for _, u := range used {
+			if uint64(u.last) < next || u.first > space.last {
+				continue
+			}
+			if uint64(u.first) > next {
+				result = append(result, addressRange{
+					first: uint32(next),
+					last:  u.first - 1,
+				})
+			}
+			next = uint64(u.last) + 1
+		}
+		if next <= uint64(space.last) {
+			result = append(result, addressRange{
+				first: uint32(next),
+				last:  space.last,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func parseIPv4AddressRanges(input []string) ([]addressRange, error) {
+	result := make([]addressRange, 0)
+	for _, v := range input {
+		r, err := parseIPv4AddressRange(v)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+
+// mergeAddressRanges sorts the ranges and combines any which overlap or are adjacent
+func mergeAddressRanges(input []addressRange) []addressRange {
+	sorted := make([]addressRange, len(input))
+	copy(sorted, input)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].first < sorted[j].first
+	})
+
+	result := make([]addressRange, 0)
+	for _, r := range sorted {
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if uint64(r.first) <= uint64(last.last)+1 {
+				if r.last > last.last {
+					last.last = r.last
+				}
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// addressRangeToPrefixes splits the range into the smallest set of CIDRs which exactly cover it
+func addressRangeToPrefixes(r addressRange) []string {
+	result := make([]string, 0)
+
+	start := uint64(r.first)
+	end := uint64(r.last)
+	for start <= end {
+		// the largest block which is aligned at the start of the range and doesn't extend beyond the end of it
+		prefixLength := 32
+		for prefixLength > 0 {
+			size := uint64(1) << uint(32-prefixLength+1)
+			if start%size != 0 || start+size-1 > end {
+				break
+			}
+			prefixLength--
+		}
+
+		result = append(result, fmt.Sprintf("%s/%d", uint32ToIPv4(uint32(start)), prefixLength))
+		start += uint64(1) << uint(32-prefixLength)
+	}
+
+	return result
+}
+
+// addressPrefixOverlaps returns the first of the used prefixes which overlaps the prefix, if any
+func addressPrefixOverlaps(prefix string, usedPrefixes []string) (*string, error) {
+	r, err := parseIPv4AddressRange(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("%q is not an IPv4 CIDR", prefix)
+	}
+
+	for _, v := range usedPrefixes {
+		used, err := parseIPv4AddressRange(v)
+		if err != nil {
+			return nil, err
+		}
+		if used != nil && used.first <= r.last && r.first <= used.last {
+			overlap := v
+			return &overlap, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ipv4ToUint32(ip net.IP) uint32 {
+	ip = ip.To4()
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+}
+
+func uint32ToIPv4(v uint32) net.IP {
+	return net.IPv4(byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
@@ -0,0 +1,397 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+func TestAvailableAddressPrefixes(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AddressSpaces []string
+		UsedPrefixes  []string
+		Expected      []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "Empty Virtual Network",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{},
+			Expected:      []string{"10.0.0.0/16"},
+		},
+		{
+			Name:          "Fully Used",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.0/25", "10.0.0.128/25"},
+			Expected:      []string{},
+		},
+		{
+			Name:          "Subnet At The Start",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/24"},
+			Expected: []string{
+				"10.0.1.0/24",
+				"10.0.2.0/23",
+				"10.0.4.0/22",
+				"10.0.8.0/21",
+				"10.0.16.0/20",
+				"10.0.32.0/19",
+				"10.0.64.0/18",
+				"10.0.128.0/17",
+			},
+		},
+		{
+			Name:          "Subnet In The Middle",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.64/26"},
+			Expected:      []string{"10.0.0.0/26", "10.0.0.128/25"},
+		},
+		{
+			Name:          "Subnet At The End",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.240/28"},
+			Expected: []string{
+				"10.0.0.0/25",
+				"10.0.0.128/26",
+				"10.0.0.192/27",
+				"10.0.0.224/28",
+			},
+		},
+		{
+			Name:          "Unsorted And Overlapping Subnets",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.128/26", "10.0.0.0/25", "10.0.0.64/26"},
+			Expected:      []string{"10.0.0.192/26"},
+		},
+		{
+			Name:          "Multiple Address Spaces",
+			AddressSpaces: []string{"192.168.0.0/24", "10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.0/25", "192.168.0.128/25"},
+			Expected:      []string{"10.0.0.128/25", "192.168.0.0/25"},
+		},
+		{
+			Name:          "Used Prefix Outside Of The Address Space",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.1.0.0/24"},
+			Expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			Name:          "Non Canonical Address Space",
+			AddressSpaces: []string{"10.0.0.5/24"},
+			UsedPrefixes:  []string{},
+			Expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			Name:          "Entire IPv4 Address Space",
+			AddressSpaces: []string{"0.0.0.0/0"},
+			UsedPrefixes:  []string{"128.0.0.0/1"},
+			Expected:      []string{"0.0.0.0/1"},
+		},
+		{
+			Name:          "End Of The IPv4 Address Space",
+			AddressSpaces: []string{"255.255.255.0/24"},
+			UsedPrefixes:  []string{"255.255.255.0/25"},
+			Expected:      []string{"255.255.255.128/25"},
+		},
+		{
+			Name:          "IPv6 Is Ignored",
+			AddressSpaces: []string{"10.0.0.0/24", "fd00:db8:deca::/48"},
+			UsedPrefixes:  []string{"10.0.0.0/25", "fd00:db8:deca::/64"},
+			Expected:      []string{"10.0.0.128/25"},
+		},
+		{
+			Name:          "Invalid Address Space",
+			AddressSpaces: []string{"10.0.0.0"},
+			UsedPrefixes:  []string{},
+			ExpectError:   true,
+		},
+		{
+			Name:          "Invalid Used Prefix",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.0/33"},
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := availableAddressPrefixes(v.AddressSpaces, v.UsedPrefixes)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func TestNextAvailableAddressPrefix(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AddressSpaces []string
+		UsedPrefixes  []string
+		PrefixLength  int
+		Expected      string
+		ExpectError   bool
+	}{
+		{
+			Name:          "Empty Virtual Network",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{},
+			PrefixLength:  24,
+			Expected:      "10.0.0.0/24",
+		},
+		{
+			Name:          "Whole Address Space",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{},
+			PrefixLength:  16,
+			Expected:      "10.0.0.0/16",
+		},
+		{
+			Name:          "After Existing Subnets",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.0.2.0/24",
+		},
+		{
+			Name:          "Fills A Gap",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/24", "10.0.2.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.0.1.0/24",
+		},
+		{
+			Name:          "Gap Too Small",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/24", "10.0.2.0/24"},
+			PrefixLength:  23,
+			Expected:      "10.0.4.0/23",
+		},
+		{
+			Name:          "Aligned To The Prefix Length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/28"},
+			PrefixLength:  24,
+			Expected:      "10.0.1.0/24",
+		},
+		{
+			Name:          "Small Subnet Alongside A Larger One",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/28"},
+			PrefixLength:  28,
+			Expected:      "10.0.0.16/28",
+		},
+		{
+			Name:          "Second Address Space",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/25"},
+			PrefixLength:  24,
+			Expected:      "10.1.0.0/24",
+		},
+		{
+			Name:          "Excluded Prefixes",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.0.0/23"},
+			PrefixLength:  24,
+			Expected:      "10.0.2.0/24",
+		},
+		{
+			Name:          "End Of The IPv4 Address Space",
+			AddressSpaces: []string{"255.255.255.0/24"},
+			UsedPrefixes:  []string{"255.255.255.0/25"},
+			PrefixLength:  25,
+			Expected:      "255.255.255.128/25",
+		},
+		{
+			Name:          "No Space Available",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{"10.0.0.0/25", "10.0.0.192/26"},
+			PrefixLength:  25,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Larger Than The Address Space",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedPrefixes:  []string{},
+			PrefixLength:  23,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Only IPv6 Address Space",
+			AddressSpaces: []string{"fd00:db8:deca::/48"},
+			UsedPrefixes:  []string{},
+			PrefixLength:  24,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Invalid Prefix Length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedPrefixes:  []string{},
+			PrefixLength:  33,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := nextAvailableAddressPrefix(v.AddressSpaces, v.UsedPrefixes, v.PrefixLength)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if v.Expected != actual {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAddressPrefixOverlaps(t *testing.T) {
+	testData := []struct {
+		Name         string
+		Prefix       string
+		UsedPrefixes []string
+		Expected     *string
+		ExpectError  bool
+	}{
+		{
+			Name:         "No Used Prefixes",
+			Prefix:       "10.0.0.0/24",
+			UsedPrefixes: []string{},
+		},
+		{
+			Name:         "Adjacent Prefixes",
+			Prefix:       "10.0.1.0/24",
+			UsedPrefixes: []string{"10.0.0.0/24", "10.0.2.0/24"},
+		},
+		{
+			Name:         "Identical Prefix",
+			Prefix:       "10.0.1.0/24",
+			UsedPrefixes: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			Expected:     stringPointer("10.0.1.0/24"),
+		},
+		{
+			Name:         "Contained Within A Used Prefix",
+			Prefix:       "10.0.1.0/28",
+			UsedPrefixes: []string{"10.0.0.0/23"},
+			Expected:     stringPointer("10.0.0.0/23"),
+		},
+		{
+			Name:         "Contains A Used Prefix",
+			Prefix:       "10.0.0.0/23",
+			UsedPrefixes: []string{"10.0.1.16/28"},
+			Expected:     stringPointer("10.0.1.16/28"),
+		},
+		{
+			Name:         "IPv6 Used Prefixes Are Ignored",
+			Prefix:       "10.0.0.0/24",
+			UsedPrefixes: []string{"fd00:db8:deca::/64"},
+		},
+		{
+			Name:         "IPv6 Prefix",
+			Prefix:       "fd00:db8:deca::/64",
+			UsedPrefixes: []string{},
+			ExpectError:  true,
+		},
+		{
+			Name:         "Invalid Prefix",
+			Prefix:       "10.0.0.0",
+			UsedPrefixes: []string{},
+			ExpectError:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := addressPrefixOverlaps(v.Prefix, v.UsedPrefixes)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func stringPointer(input string) *string {
+	return &input
+}
+
+func TestSubnetAddressAllocationPrefix(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:        "",
+			ExpectError: true,
+		},
+		{
+			Name:        "allocation1",
+			ExpectError: true,
+		},
+		{
+			Name:        "10.0.0_24",
+			ExpectError: true,
+		},
+		{
+			Name:     "10.0.0.0_24",
+			Expected: "10.0.0.0/24",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id := parse.NewSubnetAddressAllocationID("12345678-1234-9876-4563-123456789012", "group1", "network1", v.Name)
+		prefix, err := subnetAddressAllocationPrefix(id)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if prefix != v.Expected {
+			t.Fatalf("expected the Address Prefix to be %q but got %q", v.Expected, prefix)
+		}
+
+		if name := subnetAddressAllocationName(prefix); name != v.Name {
+			t.Fatalf("expected the Address Allocation Name to be %q but got %q", v.Name, name)
+		}
+	}
+}
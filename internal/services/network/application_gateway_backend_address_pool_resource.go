@@ -0,0 +1,250 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendAddressPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceApplicationGatewayBackendAddressPoolRead,
+		Update: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceApplicationGatewayBackendAddressPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"fqdns": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"ip_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.IPv4Address,
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("%s was not found", *gatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+	for _, v := range d.Get("fqdns").(*pluginsdk.Set).List() {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(v.(string)),
+		})
+	}
+	for _, v := range d.Get("ip_addresses").(*pluginsdk.Set).List() {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(v.(string)),
+		})
+	}
+
+	pool := network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(id.Name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	exists := false
+	if gateway.BackendAddressPools != nil {
+		for _, existing := range *gateway.BackendAddressPools {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+				exists = true
+				continue
+			}
+			pools = append(pools, existing)
+		}
+	}
+
+	if d.IsNewResource() && exists {
+		return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", id.ID())
+	}
+
+	pools = append(pools, pool)
+	gateway.BackendAddressPools = &pools
+
+	if err := updateApplicationGatewayChildResource(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	var pool *network.ApplicationGatewayBackendAddressPool
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, v := range *props.BackendAddressPools {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				existing := v
+				pool = &existing
+				break
+			}
+		}
+	}
+	if pool == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName).ID())
+
+	fqdns := make([]interface{}, 0)
+	ipAddresses := make([]interface{}, 0)
+	if props := pool.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil && props.BackendAddresses != nil {
+		for _, address := range *props.BackendAddresses {
+			if address.IPAddress != nil {
+				ipAddresses = append(ipAddresses, *address.IPAddress)
+			} else if address.Fqdn != nil {
+				fqdns = append(fqdns, *address.Fqdn)
+			}
+		}
+	}
+
+	if err := d.Set("fqdns", fqdns); err != nil {
+		return fmt.Errorf("setting `fqdns`: %+v", err)
+	}
+	if err := d.Set("ip_addresses", ipAddresses); err != nil {
+		return fmt.Errorf("setting `ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.BackendAddressPools == nil {
+		return nil
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	for _, existing := range *gateway.BackendAddressPools {
+		if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+			continue
+		}
+		pools = append(pools, existing)
+	}
+	gateway.BackendAddressPools = &pools
+
+	if err := updateApplicationGatewayChildResource(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
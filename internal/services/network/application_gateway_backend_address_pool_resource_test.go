@@ -0,0 +1,181 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayBackendAddressPoolResource struct{}
+
+func TestAccApplicationGatewayBackendAddressPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdns.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ip_addresses.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
+func (ApplicationGatewayBackendAddressPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendAddressPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, pool := range *props.BackendAddressPools {
+			if pool.Name != nil && strings.EqualFold(*pool.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendAddressPoolResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendAddressPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := client.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.BackendAddressPools == nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: `properties` was nil", *id)
+	}
+
+	pools := *gateway.BackendAddressPools
+	for i, pool := range pools {
+		if pool.Name != nil && strings.EqualFold(*pool.Name, id.Name) {
+			pools = append(pools[:i], pools[i+1:]...)
+			break
+		}
+	}
+	gateway.BackendAddressPools = &pools
+
+	future, err := client.Network.ApplicationGatewaysClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ApplicationGatewayName, gateway)
+	if err != nil {
+		return nil, fmt.Errorf("removing %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Network.ApplicationGatewaysClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for removal of %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "import" {
+  name                   = azurerm_application_gateway_backend_address_pool.test.name
+  application_gateway_id = azurerm_application_gateway_backend_address_pool.test.application_gateway_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  fqdns                  = ["www.example.com"]
+  ip_addresses           = ["10.1.0.4", "10.1.0.5"]
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,26 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// applicationGatewayResourceName is used to lock the Application Gateway whilst either it or the resources which manage
+// part of it (e.g. Backend Address Pools, HTTP Listeners and Request Routing Rules) perform a read-modify-write of it
+const applicationGatewayResourceName = "azurerm_application_gateway"
+
+func updateApplicationGatewayChildResource(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, gateway network.ApplicationGateway) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
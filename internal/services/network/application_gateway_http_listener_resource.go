@@ -0,0 +1,333 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayHTTPListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"frontend_port_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+				}, false),
+			},
+
+			"host_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"require_sni": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssl_certificate_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssl_profile_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayHTTPListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("%s was not found", *gatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+
+	gatewayID := gatewayId.ID()
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(id.HttpListenerName),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, d.Get("frontend_ip_configuration_name").(string))),
+			},
+			FrontendPort: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendPorts/%s", gatewayID, d.Get("frontend_port_name").(string))),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			RequireServerNameIndication: utils.Bool(d.Get("require_sni").(bool)),
+		},
+	}
+
+	if hostNames := d.Get("host_names").(*pluginsdk.Set).List(); len(hostNames) > 0 {
+		listener.HostNames = utils.ExpandStringSlice(hostNames)
+	}
+
+	if v := d.Get("ssl_certificate_name").(string); v != "" {
+		listener.SslCertificate = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/sslCertificates/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("ssl_profile_name").(string); v != "" {
+		listener.SslProfile = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/sslProfiles/%s", gatewayID, v)),
+		}
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	exists := false
+	if gateway.HTTPListeners != nil {
+		for _, existing := range *gateway.HTTPListeners {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.HttpListenerName) {
+				exists = true
+
+				// the Web Application Firewall Policy and Custom Error Pages are managed elsewhere, so are retained
+				if props := existing.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+					listener.FirewallPolicy = props.FirewallPolicy
+					listener.CustomErrorConfigurations = props.CustomErrorConfigurations
+				}
+				continue
+			}
+			listeners = append(listeners, existing)
+		}
+	}
+
+	if d.IsNewResource() && exists {
+		return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+	}
+
+	listeners = append(listeners, listener)
+	gateway.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayChildResource(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway.ApplicationGatewayPropertiesFormat, id.HttpListenerName)
+	if listener == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.HttpListenerName)
+	d.Set("application_gateway_id", parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName).ID())
+
+	if props := listener.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		frontendIPConfigurationName := ""
+		if props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.ID != nil {
+			frontendIPConfigurationId, err := parse.FrontendIPConfigurationID(*props.FrontendIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+			frontendIPConfigurationName = frontendIPConfigurationId.Name
+		}
+		d.Set("frontend_ip_configuration_name", frontendIPConfigurationName)
+
+		frontendPortName := ""
+		if props.FrontendPort != nil && props.FrontendPort.ID != nil {
+			frontendPortId, err := parse.FrontendPortID(*props.FrontendPort.ID)
+			if err != nil {
+				return err
+			}
+			frontendPortName = frontendPortId.Name
+		}
+		d.Set("frontend_port_name", frontendPortName)
+
+		d.Set("protocol", string(props.Protocol))
+		d.Set("require_sni", props.RequireServerNameIndication != nil && *props.RequireServerNameIndication)
+
+		if err := d.Set("host_names", utils.FlattenStringSlice(props.HostNames)); err != nil {
+			return fmt.Errorf("setting `host_names`: %+v", err)
+		}
+
+		sslCertificateName := ""
+		if props.SslCertificate != nil && props.SslCertificate.ID != nil {
+			sslCertificateId, err := parse.SslCertificateID(*props.SslCertificate.ID)
+			if err != nil {
+				return err
+			}
+			sslCertificateName = sslCertificateId.Name
+		}
+		d.Set("ssl_certificate_name", sslCertificateName)
+
+		sslProfileName := ""
+		if props.SslProfile != nil && props.SslProfile.ID != nil {
+			sslProfileId, err := parse.SslProfileID(*props.SslProfile.ID)
+			if err != nil {
+				return err
+			}
+			sslProfileName = sslProfileId.Name
+		}
+		d.Set("ssl_profile_name", sslProfileName)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.HTTPListeners == nil {
+		return nil
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	for _, existing := range *gateway.HTTPListeners {
+		if existing.Name != nil && strings.EqualFold(*existing.Name, id.HttpListenerName) {
+			continue
+		}
+		listeners = append(listeners, existing)
+	}
+	gateway.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayChildResource(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func findApplicationGatewayHTTPListener(input *network.ApplicationGatewayPropertiesFormat, name string) *network.ApplicationGatewayHTTPListener {
+	if input == nil || input.HTTPListeners == nil {
+		return nil
+	}
+
+	for _, v := range *input.HTTPListeners {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			listener := v
+			return &listener
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayRequestRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Read:   resourceApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Delete: resourceApplicationGatewayRequestRoutingRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayRequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayID,
+			},
+
+			"rule_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+					string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+				}, false),
+			},
+
+			"http_listener_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"backend_address_pool_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"redirect_configuration_name"},
+			},
+
+			"backend_http_settings_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"redirect_configuration_name"},
+			},
+
+			"url_path_map_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"redirect_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rewrite_rule_set_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20000),
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayRequestRoutingRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("%s was not found", *gatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+
+	gatewayID := gatewayId.ID()
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(id.RequestRoutingRuleName),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(d.Get("rule_type").(string)),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/httpListeners/%s", gatewayID, d.Get("http_listener_name").(string))),
+			},
+		},
+	}
+
+	if v := d.Get("backend_address_pool_name").(string); v != "" {
+		rule.BackendAddressPool = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("backend_http_settings_name").(string); v != "" {
+		rule.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("url_path_map_name").(string); v != "" {
+		rule.URLPathMap = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("redirect_configuration_name").(string); v != "" {
+		rule.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("rewrite_rule_set_name").(string); v != "" {
+		rule.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, v)),
+		}
+	}
+
+	if v := d.Get("priority").(int); v != 0 {
+		rule.Priority = utils.Int32(int32(v))
+	}
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	exists := false
+	if gateway.RequestRoutingRules != nil {
+		for _, existing := range *gateway.RequestRoutingRules {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.RequestRoutingRuleName) {
+				exists = true
+				continue
+			}
+			rules = append(rules, existing)
+		}
+	}
+
+	if d.IsNewResource() && exists {
+		return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", id.ID())
+	}
+
+	rules = append(rules, rule)
+	gateway.RequestRoutingRules = &rules
+
+	if err := updateApplicationGatewayChildResource(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	var rule *network.ApplicationGatewayRequestRoutingRule
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.RequestRoutingRuleName) {
+				existing := v
+				rule = &existing
+				break
+			}
+		}
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.RequestRoutingRuleName)
+	d.Set("application_gateway_id", parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName).ID())
+
+	if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+		d.Set("rule_type", string(props.RuleType))
+
+		priority := 0
+		if props.Priority != nil {
+			priority = int(*props.Priority)
+		}
+		d.Set("priority", priority)
+
+		httpListenerName := ""
+		if props.HTTPListener != nil && props.HTTPListener.ID != nil {
+			listenerId, err := parse.HttpListenerID(*props.HTTPListener.ID)
+			if err != nil {
+				return err
+			}
+			httpListenerName = listenerId.Name
+		}
+		d.Set("http_listener_name", httpListenerName)
+
+		backendAddressPoolName := ""
+		if props.BackendAddressPool != nil && props.BackendAddressPool.ID != nil {
+			poolId, err := parse.BackendAddressPoolID(*props.BackendAddressPool.ID)
+			if err != nil {
+				return err
+			}
+			backendAddressPoolName = poolId.Name
+		}
+		d.Set("backend_address_pool_name", backendAddressPoolName)
+
+		backendHTTPSettingsName := ""
+		if props.BackendHTTPSettings != nil && props.BackendHTTPSettings.ID != nil {
+			settingsId, err := parse.BackendHttpSettingsCollectionID(*props.BackendHTTPSettings.ID)
+			if err != nil {
+				return err
+			}
+			backendHTTPSettingsName = settingsId.BackendHttpSettingsCollectionName
+		}
+		d.Set("backend_http_settings_name", backendHTTPSettingsName)
+
+		urlPathMapName := ""
+		if props.URLPathMap != nil && props.URLPathMap.ID != nil {
+			pathMapId, err := parse.UrlPathMapID(*props.URLPathMap.ID)
+			if err != nil {
+				return err
+			}
+			urlPathMapName = pathMapId.Name
+		}
+		d.Set("url_path_map_name", urlPathMapName)
+
+		redirectConfigurationName := ""
+		if props.RedirectConfiguration != nil && props.RedirectConfiguration.ID != nil {
+			redirectId, err := parse.RedirectConfigurationsID(*props.RedirectConfiguration.ID)
+			if err != nil {
+				return err
+			}
+			redirectConfigurationName = redirectId.RedirectConfigurationName
+		}
+		d.Set("redirect_configuration_name", redirectConfigurationName)
+
+		rewriteRuleSetName := ""
+		if props.RewriteRuleSet != nil && props.RewriteRuleSet.ID != nil {
+			rewriteId, err := parse.RewriteRuleSetID(*props.RewriteRuleSet.ID)
+			if err != nil {
+				return err
+			}
+			rewriteRuleSetName = rewriteId.Name
+		}
+		d.Set("rewrite_rule_set_name", rewriteRuleSetName)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayRequestRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.RequestRoutingRules == nil {
+		return nil
+	}
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	for _, existing := range *gateway.RequestRoutingRules {
+		if existing.Name != nil && strings.EqualFold(*existing.Name, id.RequestRoutingRuleName) {
+			continue
+		}
+		rules = append(rules, existing)
+	}
+	gateway.RequestRoutingRules = &rules
+
+	if err := updateApplicationGatewayChildResource(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
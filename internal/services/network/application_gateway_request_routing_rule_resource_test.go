@@ -0,0 +1,133 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRequestRoutingRuleResource struct{}
+
+func TestAccApplicationGatewayRequestRoutingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 20),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRequestRoutingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 20),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRequestRoutingRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 20),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("priority").HasValue("20"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("priority").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApplicationGatewayRequestRoutingRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, rule := range *props.RequestRoutingRules {
+			if rule.Name != nil && strings.EqualFold(*rule.Name, id.RequestRoutingRuleName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) basic(data acceptance.TestData, priority int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-8080"
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_request_routing_rule" "test" {
+  name                       = "acctest-rqrt-%d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_http_listener.test.name
+  backend_address_pool_name  = local.backend_address_pool_name
+  backend_http_settings_name = local.http_setting_name
+  priority                   = %d
+}
+`, ApplicationGatewayResource{}.childResourceTemplate(data), data.RandomInteger, data.RandomInteger, priority)
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "import" {
+  name                       = azurerm_application_gateway_request_routing_rule.test.name
+  application_gateway_id     = azurerm_application_gateway_request_routing_rule.test.application_gateway_id
+  rule_type                  = azurerm_application_gateway_request_routing_rule.test.rule_type
+  http_listener_name         = azurerm_application_gateway_request_routing_rule.test.http_listener_name
+  backend_address_pool_name  = azurerm_application_gateway_request_routing_rule.test.backend_address_pool_name
+  backend_http_settings_name = azurerm_application_gateway_request_routing_rule.test.backend_http_settings_name
+  priority                   = azurerm_application_gateway_request_routing_rule.test.priority
+}
+`, r.basic(data, 20))
+}
@@ -3,6 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/staticmembers"
 )
 
 type Client struct {
@@ -25,6 +32,13 @@ type Client struct {
 	InterfacesClient                       *network.InterfacesClient
 	IPGroupsClient                         *network.IPGroupsClient
 	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
+	ManagersClient                         *networkmanagers.NetworkManagersClient
+	ManagerAdminRuleCollectionsClient      *adminrulecollections.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                *adminrules.AdminRulesClient
+	ManagerConnectivityConfigsClient       *connectivityconfigurations.ConnectivityConfigurationsClient
+	ManagerNetworkGroupsClient             *networkgroups.NetworkGroupsClient
+	ManagerSecurityAdminConfigsClient      *securityadminconfigurations.SecurityAdminConfigurationsClient
+	ManagerStaticMembersClient             *staticmembers.StaticMembersClient
 	NatRuleClient                          *network.NatRulesClient
 	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
 	ProfileClient                          *network.ProfilesClient
@@ -122,6 +136,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagersClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerConnectivityConfigsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerConnectivityConfigsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerSecurityAdminConfigsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerSecurityAdminConfigsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerStaticMembersClient := staticmembers.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -253,6 +288,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		InterfacesClient:                       &InterfacesClient,
 		IPGroupsClient:                         &IpGroupsClient,
 		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
+		ManagersClient:                         &ManagersClient,
+		ManagerAdminRuleCollectionsClient:      &ManagerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                &ManagerAdminRulesClient,
+		ManagerConnectivityConfigsClient:       &ManagerConnectivityConfigsClient,
+		ManagerNetworkGroupsClient:             &ManagerNetworkGroupsClient,
+		ManagerSecurityAdminConfigsClient:      &ManagerSecurityAdminConfigsClient,
+		ManagerStaticMembersClient:             &ManagerStaticMembersClient,
 		NatRuleClient:                          &NatRuleClient,
 		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
 		ProfileClient:                          &ProfileClient,
@@ -0,0 +1,173 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerAdminRuleCollectionResource struct{}
+
+func TestAccNetworkManagerAdminRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := ManagerAdminRuleCollectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerAdminRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := ManagerAdminRuleCollectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerAdminRuleCollection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := ManagerAdminRuleCollectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerAdminRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule_collection", "test")
+	r := ManagerAdminRuleCollectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerAdminRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := adminrulecollections.ParseRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerAdminRuleCollectionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerAdminRuleCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-manager-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+  scope_accesses = ["Connectivity", "SecurityAdmin"]
+}
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name               = "acctest-nmsac-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagerAdminRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerAdminRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "import" {
+  name                            = azurerm_network_manager_admin_rule_collection.test.name
+  security_admin_configuration_id = azurerm_network_manager_admin_rule_collection.test.security_admin_configuration_id
+  network_group_ids               = azurerm_network_manager_admin_rule_collection.test.network_group_ids
+}
+`, r.basic(data))
+}
+
+func (r ManagerAdminRuleCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+  description                     = "test admin rule collection"
+}
+`, r.template(data), data.RandomInteger)
+}
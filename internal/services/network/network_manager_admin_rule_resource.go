@@ -0,0 +1,381 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerAdminRuleModel struct {
+	Name                  string                   `tfschema:"name"`
+	AdminRuleCollectionId string                   `tfschema:"admin_rule_collection_id"`
+	Action                string                   `tfschema:"action"`
+	Description           string                   `tfschema:"description"`
+	DestinationPortRanges []string                 `tfschema:"destination_port_ranges"`
+	Destinations          []AddressPrefixItemModel `tfschema:"destination"`
+	Direction             string                   `tfschema:"direction"`
+	Priority              int64                    `tfschema:"priority"`
+	Protocol              string                   `tfschema:"protocol"`
+	SourcePortRanges      []string                 `tfschema:"source_port_ranges"`
+	Sources               []AddressPrefixItemModel `tfschema:"source"`
+}
+
+type AddressPrefixItemModel struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+type ManagerAdminRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+
+func (r ManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r ManagerAdminRuleResource) ModelObject() interface{} {
+	return &ManagerAdminRuleModel{}
+}
+
+func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrules.ValidateRuleID
+}
+
+func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: adminrulecollections.ValidateRuleCollectionID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination": addressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": addressPrefixItemSchema(),
+	}
+}
+
+func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerAdminRulesClient
+			collectionId, err := adminrulecollections.ParseRuleCollectionID(model.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrules.NewRuleID(collectionId.SubscriptionId, collectionId.ResourceGroupName, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := adminrules.AdminRule{
+				Kind: adminrules.AdminRuleKindCustom,
+				Properties: &adminrules.AdminPropertiesFormat{
+					Access:                adminrules.SecurityConfigurationRuleAccess(model.Action),
+					DestinationPortRanges: &model.DestinationPortRanges,
+					Destinations:          expandAddressPrefixItemModel(model.Destinations),
+					Direction:             adminrules.SecurityConfigurationRuleDirection(model.Direction),
+					Priority:              model.Priority,
+					Protocol:              adminrules.SecurityConfigurationRuleProtocol(model.Protocol),
+					SourcePortRanges:      &model.SourcePortRanges,
+					Sources:               expandAddressPrefixItemModel(model.Sources),
+				},
+			}
+
+			if model.Description != "" {
+				input.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: adminrulecollections.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Kind != adminrules.AdminRuleKindCustom {
+					return fmt.Errorf("retrieving %s: expected an Admin Rule of kind %q but got %q", *id, adminrules.AdminRuleKindCustom, model.Kind)
+				}
+
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Direction = string(props.Direction)
+					state.Priority = props.Priority
+					state.Protocol = string(props.Protocol)
+					state.Destinations = flattenAddressPrefixItemModel(props.Destinations)
+					state.Sources = flattenAddressPrefixItemModel(props.Sources)
+
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+
+					if props.DestinationPortRanges != nil {
+						state.DestinationPortRanges = *props.DestinationPortRanges
+					}
+
+					if props.SourcePortRanges != nil {
+						state.SourcePortRanges = *props.SourcePortRanges
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			rule := *existing.Model
+			props := rule.Properties
+
+			if metadata.ResourceData.HasChange("action") {
+				props.Access = adminrules.SecurityConfigurationRuleAccess(model.Action)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("destination_port_ranges") {
+				props.DestinationPortRanges = &model.DestinationPortRanges
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				props.Destinations = expandAddressPrefixItemModel(model.Destinations)
+			}
+
+			if metadata.ResourceData.HasChange("direction") {
+				props.Direction = adminrules.SecurityConfigurationRuleDirection(model.Direction)
+			}
+
+			if metadata.ResourceData.HasChange("priority") {
+				props.Priority = model.Priority
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				props.Protocol = adminrules.SecurityConfigurationRuleProtocol(model.Protocol)
+			}
+
+			if metadata.ResourceData.HasChange("source_port_ranges") {
+				props.SourcePortRanges = &model.SourcePortRanges
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				props.Sources = expandAddressPrefixItemModel(model.Sources)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, rule); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, adminrules.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func addressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandAddressPrefixItemModel(inputList []AddressPrefixItemModel) *[]adminrules.AddressPrefixItem {
+	outputList := make([]adminrules.AddressPrefixItem, 0)
+	for _, v := range inputList {
+		input := v
+		prefixType := adminrules.AddressPrefixType(input.AddressPrefixType)
+		outputList = append(outputList, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(input.AddressPrefix),
+			AddressPrefixType: &prefixType,
+		})
+	}
+
+	return &outputList
+}
+
+func flattenAddressPrefixItemModel(inputList *[]adminrules.AddressPrefixItem) []AddressPrefixItemModel {
+	outputList := make([]AddressPrefixItemModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		output := AddressPrefixItemModel{}
+
+		if input.AddressPrefix != nil {
+			output.AddressPrefix = *input.AddressPrefix
+		}
+
+		if input.AddressPrefixType != nil {
+			output.AddressPrefixType = string(*input.AddressPrefixType)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
@@ -0,0 +1,202 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerAdminRuleResource struct{}
+
+func TestAccNetworkManagerAdminRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerAdminRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerAdminRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerAdminRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerAdminRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := adminrules.ParseRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerAdminRulesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerAdminRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-manager-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+  scope_accesses = ["Connectivity", "SecurityAdmin"]
+}
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name               = "acctest-nmsac-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagerAdminRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "test" {
+  name                     = "acctest-nmar-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test.id
+  action                   = "Deny"
+  direction                = "Outbound"
+  priority                 = 1
+  protocol                 = "Tcp"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerAdminRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "import" {
+  name                     = azurerm_network_manager_admin_rule.test.name
+  admin_rule_collection_id = azurerm_network_manager_admin_rule.test.admin_rule_collection_id
+  action                   = "Deny"
+  direction                = "Outbound"
+  priority                 = 1
+  protocol                 = "Tcp"
+}
+`, r.basic(data))
+}
+
+func (r ManagerAdminRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "test" {
+  name                     = "acctest-nmar-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test.id
+  action                   = "Allow"
+  direction                = "Inbound"
+  priority                 = 2
+  protocol                 = "Udp"
+  description              = "test admin rule"
+  source_port_ranges       = ["80", "1024-65535"]
+  destination_port_ranges  = ["80"]
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "Internet"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.1.0.1"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.0.0.0/24"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
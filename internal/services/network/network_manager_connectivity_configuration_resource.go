@@ -0,0 +1,429 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerConnectivityConfigurationModel struct {
+	Name                         string                       `tfschema:"name"`
+	NetworkManagerId             string                       `tfschema:"network_manager_id"`
+	AppliesToGroups              []ConnectivityGroupItemModel `tfschema:"applies_to_group"`
+	ConnectivityTopology         string                       `tfschema:"connectivity_topology"`
+	DeleteExistingPeeringEnabled bool                         `tfschema:"delete_existing_peering_enabled"`
+	Description                  string                       `tfschema:"description"`
+	Hub                          []ConnectivityHubModel       `tfschema:"hub"`
+	GlobalMeshEnabled            bool                         `tfschema:"global_mesh_enabled"`
+}
+
+type ConnectivityGroupItemModel struct {
+	GroupConnectivity string `tfschema:"group_connectivity"`
+	GlobalMeshEnabled bool   `tfschema:"global_mesh_enabled"`
+	NetworkGroupId    string `tfschema:"network_group_id"`
+	UseHubGateway     bool   `tfschema:"use_hub_gateway"`
+}
+
+type ConnectivityHubModel struct {
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+type ManagerConnectivityConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+
+func (r ManagerConnectivityConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_connectivity_configuration"
+}
+
+func (r ManagerConnectivityConfigurationResource) ModelObject() interface{} {
+	return &ManagerConnectivityConfigurationModel{}
+}
+
+func (r ManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectivityconfigurations.ValidateConnectivityConfigurationID
+}
+
+func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"applies_to_group": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"group_connectivity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(connectivityconfigurations.PossibleValuesForGroupConnectivity(), false),
+					},
+
+					"network_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkgroups.ValidateNetworkGroupID,
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"use_hub_gateway": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"connectivity_topology": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(connectivityconfigurations.PossibleValuesForConnectivityTopology(), false),
+		},
+
+		"delete_existing_peering_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"global_mesh_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"hub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerConnectivityConfigsClient
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := connectivityconfigurations.NewConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if model.ConnectivityTopology == string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke) && len(model.Hub) == 0 {
+				return fmt.Errorf("`hub` must be specified when `connectivity_topology` is `%s`", connectivityconfigurations.ConnectivityTopologyHubAndSpoke)
+			}
+
+			input := connectivityconfigurations.ConnectivityConfiguration{
+				Properties: &connectivityconfigurations.ConnectivityConfigurationProperties{
+					AppliesToGroups:       expandConnectivityGroupItemModel(model.AppliesToGroups),
+					ConnectivityTopology:  connectivityconfigurations.ConnectivityTopology(model.ConnectivityTopology),
+					DeleteExistingPeering: expandDeleteExistingPeering(model.DeleteExistingPeeringEnabled),
+					Hubs:                  expandConnectivityHubModel(model.Hub),
+					IsGlobal:              expandConnectivityConfIsGlobal(model.GlobalMeshEnabled),
+				},
+			}
+
+			if model.Description != "" {
+				input.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigsClient
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerConnectivityConfigurationModel{
+				Name:             id.ConnectivityConfigurationName,
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AppliesToGroups = flattenConnectivityGroupItemModel(props.AppliesToGroups)
+					state.ConnectivityTopology = string(props.ConnectivityTopology)
+					state.DeleteExistingPeeringEnabled = flattenDeleteExistingPeering(props.DeleteExistingPeering)
+					state.GlobalMeshEnabled = flattenConnectivityConfIsGlobal(props.IsGlobal)
+					state.Hub = flattenConnectivityHubModel(props.Hubs)
+
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigsClient
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			configuration := *existing.Model
+			props := configuration.Properties
+
+			if metadata.ResourceData.HasChange("applies_to_group") {
+				props.AppliesToGroups = expandConnectivityGroupItemModel(model.AppliesToGroups)
+			}
+
+			if metadata.ResourceData.HasChange("connectivity_topology") {
+				props.ConnectivityTopology = connectivityconfigurations.ConnectivityTopology(model.ConnectivityTopology)
+			}
+
+			if metadata.ResourceData.HasChange("delete_existing_peering_enabled") {
+				props.DeleteExistingPeering = expandDeleteExistingPeering(model.DeleteExistingPeeringEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("global_mesh_enabled") {
+				props.IsGlobal = expandConnectivityConfIsGlobal(model.GlobalMeshEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("hub") {
+				props.Hubs = expandConnectivityHubModel(model.Hub)
+			}
+
+			if props.ConnectivityTopology == connectivityconfigurations.ConnectivityTopologyHubAndSpoke && (props.Hubs == nil || len(*props.Hubs) == 0) {
+				return fmt.Errorf("`hub` must be specified when `connectivity_topology` is `%s`", connectivityconfigurations.ConnectivityTopologyHubAndSpoke)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, configuration); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigsClient
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, connectivityconfigurations.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandConnectivityGroupItemModel(inputList []ConnectivityGroupItemModel) []connectivityconfigurations.ConnectivityGroupItem {
+	outputList := make([]connectivityconfigurations.ConnectivityGroupItem, 0)
+	for _, v := range inputList {
+		output := connectivityconfigurations.ConnectivityGroupItem{
+			GroupConnectivity: connectivityconfigurations.GroupConnectivity(v.GroupConnectivity),
+			NetworkGroupId:    v.NetworkGroupId,
+			IsGlobal:          expandConnectivityConfIsGlobal(v.GlobalMeshEnabled),
+			UseHubGateway:     expandConnectivityConfUseHubGateway(v.UseHubGateway),
+		}
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenConnectivityGroupItemModel(inputList []connectivityconfigurations.ConnectivityGroupItem) []ConnectivityGroupItemModel {
+	outputList := make([]ConnectivityGroupItemModel, 0)
+	for _, input := range inputList {
+		output := ConnectivityGroupItemModel{
+			GroupConnectivity: string(input.GroupConnectivity),
+			NetworkGroupId:    input.NetworkGroupId,
+			GlobalMeshEnabled: flattenConnectivityConfIsGlobal(input.IsGlobal),
+		}
+
+		if input.UseHubGateway != nil {
+			output.UseHubGateway = *input.UseHubGateway == connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func expandConnectivityHubModel(inputList []ConnectivityHubModel) *[]connectivityconfigurations.Hub {
+	outputList := make([]connectivityconfigurations.Hub, 0)
+	for _, v := range inputList {
+		input := v
+		outputList = append(outputList, connectivityconfigurations.Hub{
+			ResourceId:   utils.String(input.ResourceId),
+			ResourceType: utils.String(input.ResourceType),
+		})
+	}
+
+	return &outputList
+}
+
+func flattenConnectivityHubModel(inputList *[]connectivityconfigurations.Hub) []ConnectivityHubModel {
+	outputList := make([]ConnectivityHubModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		output := ConnectivityHubModel{}
+
+		if input.ResourceId != nil {
+			output.ResourceId = *input.ResourceId
+		}
+
+		if input.ResourceType != nil {
+			output.ResourceType = *input.ResourceType
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func expandDeleteExistingPeering(input bool) *connectivityconfigurations.DeleteExistingPeering {
+	output := connectivityconfigurations.DeleteExistingPeeringFalse
+	if input {
+		output = connectivityconfigurations.DeleteExistingPeeringTrue
+	}
+	return &output
+}
+
+func flattenDeleteExistingPeering(input *connectivityconfigurations.DeleteExistingPeering) bool {
+	return input != nil && *input == connectivityconfigurations.DeleteExistingPeeringTrue
+}
+
+func expandConnectivityConfIsGlobal(input bool) *connectivityconfigurations.IsGlobal {
+	output := connectivityconfigurations.IsGlobalFalse
+	if input {
+		output = connectivityconfigurations.IsGlobalTrue
+	}
+	return &output
+}
+
+func flattenConnectivityConfIsGlobal(input *connectivityconfigurations.IsGlobal) bool {
+	return input != nil && *input == connectivityconfigurations.IsGlobalTrue
+}
+
+func expandConnectivityConfUseHubGateway(input bool) *connectivityconfigurations.UseHubGateway {
+	output := connectivityconfigurations.UseHubGatewayFalse
+	if input {
+		output = connectivityconfigurations.UseHubGatewayTrue
+	}
+	return &output
+}
@@ -0,0 +1,332 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+type ManagerDeploymentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"scope_access": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			normalizedLocation := location.Normalize(model.Location)
+			id := parse.NewManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, normalizedLocation, model.ScopeAccess)
+
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			existing, err := getNetworkManagerDeploymentStatus(ctx, client, *networkManagerId, normalizedLocation, model.ScopeAccess)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := networkmanagers.NetworkManagerCommit{
+				CommitType:       networkmanagers.ConfigurationType(model.ScopeAccess),
+				ConfigurationIds: &model.ConfigurationIds,
+				TargetLocations:  []string{normalizedLocation},
+			}
+
+			if err := client.NetworkManagerCommitsPostThenPoll(ctx, *networkManagerId, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := waitForNetworkManagerDeployment(ctx, client, *networkManagerId, normalizedLocation, model.ScopeAccess); err != nil {
+				return fmt.Errorf("waiting for %s to be deployed: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.ManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+			deployment, err := getNetworkManagerDeploymentStatus(ctx, client, networkManagerId, id.Location, id.ScopeAccess)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			// `triggers` isn't returned by the API so we retain the value from the config
+			var config ManagerDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: networkManagerId.ID(),
+				Location:         location.Normalize(id.Location),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: *deployment.ConfigurationIds,
+				Triggers:         config.Triggers,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.ManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			if metadata.ResourceData.HasChanges("configuration_ids", "triggers") {
+				input := networkmanagers.NetworkManagerCommit{
+					CommitType:       networkmanagers.ConfigurationType(id.ScopeAccess),
+					ConfigurationIds: &model.ConfigurationIds,
+					TargetLocations:  []string{id.Location},
+				}
+
+				if err := client.NetworkManagerCommitsPostThenPoll(ctx, networkManagerId, input); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				if err := waitForNetworkManagerDeployment(ctx, client, networkManagerId, id.Location, id.ScopeAccess); err != nil {
+					return fmt.Errorf("waiting for %s to be deployed: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := parse.ManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			// a deployment is removed by committing an empty set of configurations to the region
+			input := networkmanagers.NetworkManagerCommit{
+				CommitType:       networkmanagers.ConfigurationType(id.ScopeAccess),
+				ConfigurationIds: &[]string{},
+				TargetLocations:  []string{id.Location},
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.NetworkManagerCommitsPostThenPoll(ctx, networkManagerId, input); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := waitForNetworkManagerDeployment(ctx, client, networkManagerId, id.Location, id.ScopeAccess); err != nil {
+				return fmt.Errorf("waiting for %s to be removed: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func getNetworkManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id networkmanagers.NetworkManagerId, region string, scopeAccess string) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(scopeAccess)},
+		Regions:         &[]string{region},
+	}
+
+	for {
+		resp, err := client.NetworkManagerDeploymentStatusList(ctx, id, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing deployment status: %+v", err)
+		}
+
+		if resp.Model == nil {
+			return nil, nil
+		}
+
+		if resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				if v.Region == nil || v.DeploymentType == nil {
+					continue
+				}
+
+				if strings.EqualFold(location.Normalize(*v.Region), location.Normalize(region)) && strings.EqualFold(string(*v.DeploymentType), scopeAccess) {
+					status := v
+					return &status, nil
+				}
+			}
+		}
+
+		if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+			return nil, nil
+		}
+		input.SkipToken = resp.Model.SkipToken
+	}
+}
+
+func waitForNetworkManagerDeployment(ctx context.Context, client *networkmanagers.NetworkManagersClient, id networkmanagers.NetworkManagerId, region string, scopeAccess string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(networkmanagers.DeploymentStatusNotStarted), string(networkmanagers.DeploymentStatusDeploying)},
+		Target:     []string{string(networkmanagers.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentRefreshFunc(ctx, client, id, region, scopeAccess),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id networkmanagers.NetworkManagerId, region string, scopeAccess string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := getNetworkManagerDeploymentStatus(ctx, client, id, region, scopeAccess)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment == nil || deployment.DeploymentStatus == nil {
+			// the commit hasn't been picked up yet
+			return "pending", string(networkmanagers.DeploymentStatusNotStarted), nil
+		}
+
+		if *deployment.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+			return deployment, string(*deployment.DeploymentStatus), fmt.Errorf("deployment failed: %s", utils.NormalizeNilableString(deployment.ErrorMessage))
+		}
+
+		return deployment, string(*deployment.DeploymentStatus), nil
+	}
+}
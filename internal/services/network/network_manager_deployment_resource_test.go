@@ -0,0 +1,194 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerDeploymentResource struct{}
+
+func TestAccNetworkManagerDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func TestAccNetworkManagerDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r ManagerDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagerDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+	resp, err := clients.Network.ManagersClient.NetworkManagerDeploymentStatusList(ctx, networkManagerId, input)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model != nil && resp.Model.Value != nil {
+		for _, v := range *resp.Model.Value {
+			if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), location.Normalize(id.Location)) {
+				continue
+			}
+			if v.ConfigurationIds != nil && len(*v.ConfigurationIds) > 0 {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ManagerDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-manager-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+  scope_accesses = ["Connectivity", "SecurityAdmin"]
+}
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+  applies_to_group {
+    group_connectivity = "None"
+    network_group_id   = azurerm_network_manager_network_group.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagerDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = "%s"
+  scope_access       = "Connectivity"
+  configuration_ids  = [azurerm_network_manager_connectivity_configuration.test.id]
+}
+`, r.template(data), data.Locations.Primary)
+}
+
+func (r ManagerDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "import" {
+  network_manager_id = azurerm_network_manager_deployment.test.network_manager_id
+  location           = azurerm_network_manager_deployment.test.location
+  scope_access       = azurerm_network_manager_deployment.test.scope_access
+  configuration_ids  = azurerm_network_manager_deployment.test.configuration_ids
+}
+`, r.basic(data))
+}
+
+func (r ManagerDeploymentResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test2" {
+  name                  = "acctest-nmcc2-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+  applies_to_group {
+    group_connectivity = "DirectlyConnected"
+    network_group_id   = azurerm_network_manager_network_group.test.id
+  }
+}
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = "%s"
+  scope_access       = "Connectivity"
+  configuration_ids = [
+    azurerm_network_manager_connectivity_configuration.test.id,
+    azurerm_network_manager_connectivity_configuration.test2.id,
+  ]
+  triggers = {
+    source = "update"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
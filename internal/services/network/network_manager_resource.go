@@ -0,0 +1,377 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerModel struct {
+	Name              string                    `tfschema:"name"`
+	ResourceGroupName string                    `tfschema:"resource_group_name"`
+	Location          string                    `tfschema:"location"`
+	Description       string                    `tfschema:"description"`
+	Scope             []ManagerScopeModel       `tfschema:"scope"`
+	ScopeAccesses     []string                  `tfschema:"scope_accesses"`
+	CrossTenantScopes []ManagerCrossTenantScope `tfschema:"cross_tenant_scopes"`
+	Tags              map[string]string         `tfschema:"tags"`
+}
+
+type ManagerScopeModel struct {
+	ManagementGroupIds []string `tfschema:"management_group_ids"`
+	SubscriptionIds    []string `tfschema:"subscription_ids"`
+}
+
+type ManagerCrossTenantScope struct {
+	TenantId         string   `tfschema:"tenant_id"`
+	ManagementGroups []string `tfschema:"management_groups"`
+	Subscriptions    []string `tfschema:"subscriptions"`
+}
+
+type ManagerResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerResource{}
+
+func (r ManagerResource) ResourceType() string {
+	return "azurerm_network_manager"
+}
+
+func (r ManagerResource) ModelObject() interface{} {
+	return &ManagerModel{}
+}
+
+func (r ManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkmanagers.ValidateNetworkManagerID
+}
+
+func (r ManagerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"scope": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"management_group_ids": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateManagementGroupID,
+						},
+					},
+
+					"subscription_ids": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateSubscriptionID,
+						},
+					},
+				},
+			},
+		},
+
+		"scope_accesses": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cross_tenant_scopes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"management_groups": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"subscriptions": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state ManagerModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := networkmanagers.NewNetworkManagerID(subscriptionId, state.ResourceGroupName, state.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := networkmanagers.NetworkManager{
+				Location: utils.String(location.Normalize(state.Location)),
+				Properties: &networkmanagers.NetworkManagerProperties{
+					Description:                 utils.String(state.Description),
+					NetworkManagerScopes:        expandNetworkManagerScope(state.Scope),
+					NetworkManagerScopeAccesses: expandNetworkManagerScopeAccesses(state.ScopeAccesses),
+				},
+				Tags: &state.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerModel{
+				Name:              id.NetworkManagerName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+					state.Scope = flattenNetworkManagerScope(props.NetworkManagerScopes)
+					state.ScopeAccesses = flattenNetworkManagerScopeAccesses(props.NetworkManagerScopeAccesses)
+					state.CrossTenantScopes = flattenNetworkManagerCrossTenantScopes(props.NetworkManagerScopes.CrossTenantScopes)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagerModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			model := *existing.Model
+			if metadata.ResourceData.HasChange("description") {
+				model.Properties.Description = utils.String(state.Description)
+			}
+
+			if metadata.ResourceData.HasChange("scope") {
+				model.Properties.NetworkManagerScopes = expandNetworkManagerScope(state.Scope)
+			}
+
+			if metadata.ResourceData.HasChange("scope_accesses") {
+				model.Properties.NetworkManagerScopeAccesses = expandNetworkManagerScopeAccesses(state.ScopeAccesses)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				model.Tags = &state.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, networkmanagers.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerScope(input []ManagerScopeModel) networkmanagers.NetworkManagerPropertiesNetworkManagerScopes {
+	if len(input) == 0 {
+		return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{}
+	}
+
+	return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{
+		ManagementGroups: &input[0].ManagementGroupIds,
+		Subscriptions:    &input[0].SubscriptionIds,
+	}
+}
+
+func flattenNetworkManagerScope(input networkmanagers.NetworkManagerPropertiesNetworkManagerScopes) []ManagerScopeModel {
+	output := ManagerScopeModel{
+		ManagementGroupIds: make([]string, 0),
+		SubscriptionIds:    make([]string, 0),
+	}
+
+	if input.ManagementGroups != nil {
+		output.ManagementGroupIds = *input.ManagementGroups
+	}
+
+	if input.Subscriptions != nil {
+		output.SubscriptionIds = *input.Subscriptions
+	}
+
+	return []ManagerScopeModel{output}
+}
+
+func expandNetworkManagerScopeAccesses(input []string) []networkmanagers.ConfigurationType {
+	output := make([]networkmanagers.ConfigurationType, 0)
+	for _, v := range input {
+		output = append(output, networkmanagers.ConfigurationType(v))
+	}
+	return output
+}
+
+func flattenNetworkManagerScopeAccesses(input []networkmanagers.ConfigurationType) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func flattenNetworkManagerCrossTenantScopes(input *[]networkmanagers.CrossTenantScopes) []ManagerCrossTenantScope {
+	output := make([]ManagerCrossTenantScope, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		scope := ManagerCrossTenantScope{
+			ManagementGroups: make([]string, 0),
+			Subscriptions:    make([]string, 0),
+		}
+
+		if v.TenantId != nil {
+			scope.TenantId = *v.TenantId
+		}
+
+		if v.ManagementGroups != nil {
+			scope.ManagementGroups = *v.ManagementGroups
+		}
+
+		if v.Subscriptions != nil {
+			scope.Subscriptions = *v.Subscriptions
+		}
+
+		output = append(output, scope)
+	}
+
+	return output
+}
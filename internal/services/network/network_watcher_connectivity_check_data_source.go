@@ -0,0 +1,362 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherConnectivityCheck() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherConnectivityCheckRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.NetworkWatcherID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"virtual_machine_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: computeValidate.VirtualMachineID,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+					},
+				},
+			},
+
+			"destination": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"virtual_machine_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: computeValidate.VirtualMachineID,
+							ExactlyOneOf: []string{"destination.0.virtual_machine_id", "destination.0.address"},
+						},
+
+						"address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"destination.0.virtual_machine_id", "destination.0.address"},
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+					},
+				},
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.ProtocolTCP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+					string(network.ProtocolIcmp),
+					string(network.ProtocolTCP),
+				}, false),
+			},
+
+			"preferred_ip_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPVersionIPv4),
+					string(network.IPVersionIPv6),
+				}, false),
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"average_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"minimum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"maximum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_sent": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_failed": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"hop": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"issue": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"origin": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"severity": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherConnectivityCheckRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.ConnectivityParameters{
+		Source:      expandNetworkWatcherConnectivitySource(d.Get("source").([]interface{})),
+		Destination: expandNetworkWatcherConnectivityDestination(d.Get("destination").([]interface{})),
+		Protocol:    network.Protocol(d.Get("protocol").(string)),
+	}
+	if v := d.Get("preferred_ip_version").(string); v != "" {
+		parameters.PreferredIPVersion = network.IPVersion(v)
+	}
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.CheckConnectivity(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("checking connectivity using %s: %+v", *watcherId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for connectivity check using %s: %+v", *watcherId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving connectivity check result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/connectivityCheck", watcherId.ID()))
+
+	d.Set("network_watcher_id", watcherId.ID())
+	d.Set("connection_status", string(result.ConnectionStatus))
+
+	averageLatency := 0
+	if result.AvgLatencyInMs != nil {
+		averageLatency = int(*result.AvgLatencyInMs)
+	}
+	d.Set("average_latency_in_ms", averageLatency)
+
+	minimumLatency := 0
+	if result.MinLatencyInMs != nil {
+		minimumLatency = int(*result.MinLatencyInMs)
+	}
+	d.Set("minimum_latency_in_ms", minimumLatency)
+
+	maximumLatency := 0
+	if result.MaxLatencyInMs != nil {
+		maximumLatency = int(*result.MaxLatencyInMs)
+	}
+	d.Set("maximum_latency_in_ms", maximumLatency)
+
+	probesSent := 0
+	if result.ProbesSent != nil {
+		probesSent = int(*result.ProbesSent)
+	}
+	d.Set("probes_sent", probesSent)
+
+	probesFailed := 0
+	if result.ProbesFailed != nil {
+		probesFailed = int(*result.ProbesFailed)
+	}
+	d.Set("probes_failed", probesFailed)
+
+	if err := d.Set("hop", flattenNetworkWatcherConnectivityHops(result.Hops)); err != nil {
+		return fmt.Errorf("setting `hop`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandNetworkWatcherConnectivitySource(input []interface{}) *network.ConnectivitySource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := network.ConnectivitySource{
+		ResourceID: utils.String(v["virtual_machine_id"].(string)),
+	}
+	if port := v["port"].(int); port != 0 {
+		result.Port = utils.Int32(int32(port))
+	}
+
+	return &result
+}
+
+func expandNetworkWatcherConnectivityDestination(input []interface{}) *network.ConnectivityDestination {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := network.ConnectivityDestination{}
+	if resourceId := v["virtual_machine_id"].(string); resourceId != "" {
+		result.ResourceID = utils.String(resourceId)
+	}
+	if address := v["address"].(string); address != "" {
+		result.Address = utils.String(address)
+	}
+	if port := v["port"].(int); port != 0 {
+		result.Port = utils.Int32(int32(port))
+	}
+
+	return &result
+}
+
+func flattenNetworkWatcherConnectivityHops(input *[]network.ConnectivityHop) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		hopType := ""
+		if item.Type != nil {
+			hopType = *item.Type
+		}
+
+		address := ""
+		if item.Address != nil {
+			address = *item.Address
+		}
+
+		resourceId := ""
+		if item.ResourceID != nil {
+			resourceId = *item.ResourceID
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":           id,
+			"type":         hopType,
+			"address":      address,
+			"resource_id":  resourceId,
+			"next_hop_ids": utils.FlattenStringSlice(item.NextHopIds),
+			"issue":        flattenNetworkWatcherConnectivityIssues(item.Issues),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkWatcherConnectivityIssues(input *[]network.ConnectivityIssue) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"origin":   string(item.Origin),
+			"severity": string(item.Severity),
+			"type":     string(item.Type),
+		})
+	}
+
+	return results
+}
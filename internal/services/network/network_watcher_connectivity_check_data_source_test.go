@@ -0,0 +1,46 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("probes_sent").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+
+  source {
+    virtual_machine_id = azurerm_virtual_machine_extension.test.virtual_machine_id
+  }
+
+  destination {
+    address = "www.bing.com"
+    port    = 443
+  }
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
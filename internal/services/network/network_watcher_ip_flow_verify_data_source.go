@@ -0,0 +1,167 @@
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherIPFlowVerify() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherIPFlowVerifyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.NetworkWatcherID,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.NetworkInterfaceID,
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.DirectionInbound),
+					string(network.DirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPFlowProtocolTCP),
+					string(network.IPFlowProtocolUDP),
+				}, false),
+			},
+
+			"local_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_port": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkWatcherPortValidation,
+			},
+
+			"remote_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"remote_port": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkWatcherPortValidation,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherIPFlowVerifyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VerificationIPFlowParameters{
+		TargetResourceID: utils.String(d.Get("virtual_machine_id").(string)),
+		Direction:        network.Direction(d.Get("direction").(string)),
+		Protocol:         network.IPFlowProtocol(d.Get("protocol").(string)),
+		LocalIPAddress:   utils.String(d.Get("local_ip_address").(string)),
+		LocalPort:        utils.String(d.Get("local_port").(string)),
+		RemoteIPAddress:  utils.String(d.Get("remote_ip_address").(string)),
+		RemotePort:       utils.String(d.Get("remote_port").(string)),
+	}
+	if v := d.Get("network_interface_id").(string); v != "" {
+		parameters.TargetNicResourceID = utils.String(v)
+	}
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.VerifyIPFlow(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("verifying IP Flow using %s: %+v", *watcherId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for IP Flow verification using %s: %+v", *watcherId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving IP Flow verification result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/ipFlowVerify", watcherId.ID()))
+
+	d.Set("network_watcher_id", watcherId.ID())
+	d.Set("access", string(result.Access))
+	d.Set("rule_name", result.RuleName)
+
+	return nil
+}
+
+// networkWatcherPortValidation validates a port used by the Network Watcher diagnostics, which can either be a single
+// port or `*` to indicate any port
+func networkWatcherPortValidation(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "*" {
+		return
+	}
+
+	port, err := strconv.Atoi(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a port number or `*` but got %q", k, v))
+		return
+	}
+
+	return validate.PortNumber(port, k)
+}
@@ -0,0 +1,43 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("defaultSecurityRules/AllowInternetOutBound"),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  virtual_machine_id = azurerm_virtual_machine_extension.test.virtual_machine_id
+  direction          = "Outbound"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.test.private_ip_address
+  local_port         = "*"
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = "443"
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
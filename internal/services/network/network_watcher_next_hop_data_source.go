@@ -0,0 +1,115 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherNextHop() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherNextHopRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.NetworkWatcherID,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.NetworkInterfaceID,
+			},
+
+			"source_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"next_hop_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"next_hop_ip_address": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherNextHopRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.NextHopParameters{
+		TargetResourceID:     utils.String(d.Get("virtual_machine_id").(string)),
+		SourceIPAddress:      utils.String(d.Get("source_ip_address").(string)),
+		DestinationIPAddress: utils.String(d.Get("destination_ip_address").(string)),
+	}
+	if v := d.Get("network_interface_id").(string); v != "" {
+		parameters.TargetNicResourceID = utils.String(v)
+	}
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.GetNextHop(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("retrieving Next Hop using %s: %+v", *watcherId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for retrieval of Next Hop using %s: %+v", *watcherId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Next Hop using %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/nextHop", watcherId.ID()))
+
+	d.Set("network_watcher_id", watcherId.ID())
+	d.Set("next_hop_type", string(result.NextHopType))
+	d.Set("next_hop_ip_address", result.NextHopIPAddress)
+	d.Set("route_table_id", result.RouteTableID)
+
+	return nil
+}
@@ -33,7 +33,10 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"disappears":     testAccNetworkWatcher_disappears,
 		},
 		"DataSource": {
-			"basic": testAccDataSourceNetworkWatcher_basic,
+			"basic":             testAccDataSourceNetworkWatcher_basic,
+			"connectivityCheck": testAccDataSourceNetworkWatcherConnectivityCheck_basic,
+			"ipFlowVerify":      testAccDataSourceNetworkWatcherIPFlowVerify_basic,
+			"nextHop":           testAccDataSourceNetworkWatcherNextHop_basic,
 		},
 		"ConnectionMonitor": {
 			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
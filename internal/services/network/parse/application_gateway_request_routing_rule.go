@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApplicationGatewayRequestRoutingRuleId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	RequestRoutingRuleName string
+}
+
+func NewApplicationGatewayRequestRoutingRuleID(subscriptionId, resourceGroup, applicationGatewayName, requestRoutingRuleName string) ApplicationGatewayRequestRoutingRuleId {
+	return ApplicationGatewayRequestRoutingRuleId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		RequestRoutingRuleName: requestRoutingRuleName,
+	}
+}
+
+func (id ApplicationGatewayRequestRoutingRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Request Routing Rule Name %q", id.RequestRoutingRuleName),
+		fmt.Sprintf("Application Gateway Name %q", id.ApplicationGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Gateway Request Routing Rule", segmentsStr)
+}
+
+func (id ApplicationGatewayRequestRoutingRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/requestRoutingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.RequestRoutingRuleName)
+}
+
+// ApplicationGatewayRequestRoutingRuleID parses a ApplicationGatewayRequestRoutingRule ID into an ApplicationGatewayRequestRoutingRuleId struct
+func ApplicationGatewayRequestRoutingRuleID(input string) (*ApplicationGatewayRequestRoutingRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationGatewayRequestRoutingRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.RequestRoutingRuleName, err = id.PopSegment("requestRoutingRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+)
+
+type ManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	Location           string
+	ScopeAccess        string
+}
+
+func NewManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, location, scopeAccess string) ManagerDeploymentId {
+	return ManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		Location:           location,
+		ScopeAccess:        scopeAccess,
+	}
+}
+
+func (id ManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Scope Access %q", id.ScopeAccess),
+		fmt.Sprintf("Location %q", id.Location),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Manager Deployment", segmentsStr)
+}
+
+func (id ManagerDeploymentId) ID() string {
+	networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	return fmt.Sprintf("%s/commit|%s|%s", networkManagerId.ID(), id.Location, id.ScopeAccess)
+}
+
+// ManagerDeploymentID parses a Network Manager Deployment ID, which is in the format
+// `{networkManagerId}/commit|{location}|{scopeAccess}`
+func ManagerDeploymentID(input string) (*ManagerDeploymentId, error) {
+	parts := strings.Split(input, "/commit|")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkManagerId}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Manager ID %q: %+v", parts[0], err)
+	}
+
+	segments := strings.Split(parts[1], "|")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("expected an ID in the format `{networkManagerId}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	return &ManagerDeploymentId{
+		SubscriptionId:     networkManagerId.SubscriptionId,
+		ResourceGroup:      networkManagerId.ResourceGroupName,
+		NetworkManagerName: networkManagerId.NetworkManagerName,
+		Location:           segments[0],
+		ScopeAccess:        segments[1],
+	}, nil
+}
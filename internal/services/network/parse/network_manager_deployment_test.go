@@ -0,0 +1,90 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestManagerDeploymentIDFormatter(t *testing.T) {
+	actual := NewManagerDeploymentID("12345678-1234-9876-4563-123456789012", "resGroup1", "manager1", "eastus", "Connectivity").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus|Connectivity"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagerDeploymentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// network manager id only
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			// missing scope access
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus",
+			Error: true,
+		},
+		{
+			// empty location
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit||Connectivity",
+			Error: true,
+		},
+		{
+			// invalid network manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/commit|eastus|Connectivity",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus|Connectivity",
+			Expected: &ManagerDeploymentId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				NetworkManagerName: "manager1",
+				Location:           "eastus",
+				ScopeAccess:        "Connectivity",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.NetworkManagerName != v.Expected.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expected.NetworkManagerName, actual.NetworkManagerName)
+		}
+		if actual.Location != v.Expected.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expected.Location, actual.Location)
+		}
+		if actual.ScopeAccess != v.Expected.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for ScopeAccess", v.Expected.ScopeAccess, actual.ScopeAccess)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SubnetAddressAllocationId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	VirtualNetworkName    string
+	AddressAllocationName string
+}
+
+func NewSubnetAddressAllocationID(subscriptionId, resourceGroup, virtualNetworkName, addressAllocationName string) SubnetAddressAllocationId {
+	return SubnetAddressAllocationId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		VirtualNetworkName:    virtualNetworkName,
+		AddressAllocationName: addressAllocationName,
+	}
+}
+
+func (id SubnetAddressAllocationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Address Allocation Name %q", id.AddressAllocationName),
+		fmt.Sprintf("Virtual Network Name %q", id.VirtualNetworkName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Subnet Address Allocation", segmentsStr)
+}
+
+func (id SubnetAddressAllocationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/addressAllocations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.AddressAllocationName)
+}
+
+// SubnetAddressAllocationID parses a SubnetAddressAllocation ID into an SubnetAddressAllocationId struct
+func SubnetAddressAllocationID(input string) (*SubnetAddressAllocationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubnetAddressAllocationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, err
+	}
+	if resourceId.AddressAllocationName, err = id.PopSegment("addressAllocations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// privateEndpointDnsZoneNamesForEnvironment maps the Sub Resource Names (Group IDs) used by a Private Endpoint
+// to the Private DNS Zones which should be used for that Sub Resource, per Azure Environment.
+// See: https://docs.microsoft.com/azure/private-link/private-endpoint-dns
+//
+// note: the casing on these keys is important - some Sub Resource Names (e.g. `table` for Storage and `Table`
+// for Cosmos DB) differ only by casing
+var privateEndpointDnsZoneNamesForEnvironment = map[string]map[string][]string{
+	azure.PublicCloud.Name: {
+		"amlworkspace":        {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+		"batchAccount":        {"privatelink.batch.azure.com"},
+		"blob":                {"privatelink.blob.core.windows.net"},
+		"blob_secondary":      {"privatelink.blob.core.windows.net"},
+		"Cassandra":           {"privatelink.cassandra.cosmos.azure.com"},
+		"configurationStores": {"privatelink.azconfig.io"},
+		"dataFactory":         {"privatelink.datafactory.azure.net"},
+		"dfs":                 {"privatelink.dfs.core.windows.net"},
+		"dfs_secondary":       {"privatelink.dfs.core.windows.net"},
+		"domain":              {"privatelink.eventgrid.azure.net"},
+		"DSCAndHybridWorker":  {"privatelink.azure-automation.net"},
+		"file":                {"privatelink.file.core.windows.net"},
+		"Gremlin":             {"privatelink.gremlin.cosmos.azure.com"},
+		"iotHub":              {"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+		"managedhsm":          {"privatelink.managedhsm.azure.net"},
+		"mariadbServer":       {"privatelink.mariadb.database.azure.com"},
+		"MongoDB":             {"privatelink.mongo.cosmos.azure.com"},
+		"mysqlServer":         {"privatelink.mysql.database.azure.com"},
+		"namespace":           {"privatelink.servicebus.windows.net"},
+		"portal":              {"privatelink.adf.azure.com"},
+		"postgresqlServer":    {"privatelink.postgres.database.azure.com"},
+		"queue":               {"privatelink.queue.core.windows.net"},
+		"queue_secondary":     {"privatelink.queue.core.windows.net"},
+		"redisCache":          {"privatelink.redis.cache.windows.net"},
+		"registry":            {"privatelink.azurecr.io"},
+		"searchService":       {"privatelink.search.windows.net"},
+		"signalr":             {"privatelink.service.signalr.net"},
+		"sites":               {"privatelink.azurewebsites.net"},
+		"Sql":                 {"privatelink.documents.azure.com"},
+		"sqlServer":           {"privatelink.database.windows.net"},
+		"table":               {"privatelink.table.core.windows.net"},
+		"Table":               {"privatelink.table.cosmos.azure.com"},
+		"table_secondary":     {"privatelink.table.core.windows.net"},
+		"topic":               {"privatelink.eventgrid.azure.net"},
+		"vault":               {"privatelink.vaultcore.azure.net"},
+		"web":                 {"privatelink.web.core.windows.net"},
+		"web_secondary":       {"privatelink.web.core.windows.net"},
+		"Webhook":             {"privatelink.azure-automation.net"},
+	},
+	azure.ChinaCloud.Name: {
+		"amlworkspace":        {"privatelink.api.ml.azure.cn", "privatelink.notebooks.chinacloudapi.cn"},
+		"batchAccount":        {"privatelink.batch.chinacloudapi.cn"},
+		"blob":                {"privatelink.blob.core.chinacloudapi.cn"},
+		"blob_secondary":      {"privatelink.blob.core.chinacloudapi.cn"},
+		"Cassandra":           {"privatelink.cassandra.cosmos.azure.cn"},
+		"configurationStores": {"privatelink.azconfig.azure.cn"},
+		"dataFactory":         {"privatelink.datafactory.azure.cn"},
+		"dfs":                 {"privatelink.dfs.core.chinacloudapi.cn"},
+		"dfs_secondary":       {"privatelink.dfs.core.chinacloudapi.cn"},
+		"domain":              {"privatelink.eventgrid.azure.cn"},
+		"DSCAndHybridWorker":  {"privatelink.azure-automation.cn"},
+		"file":                {"privatelink.file.core.chinacloudapi.cn"},
+		"Gremlin":             {"privatelink.gremlin.cosmos.azure.cn"},
+		"iotHub":              {"privatelink.azure-devices.cn", "privatelink.servicebus.chinacloudapi.cn"},
+		"mariadbServer":       {"privatelink.mariadb.database.chinacloudapi.cn"},
+		"MongoDB":             {"privatelink.mongo.cosmos.azure.cn"},
+		"mysqlServer":         {"privatelink.mysql.database.chinacloudapi.cn"},
+		"namespace":           {"privatelink.servicebus.chinacloudapi.cn"},
+		"portal":              {"privatelink.adf.azure.cn"},
+		"postgresqlServer":    {"privatelink.postgres.database.chinacloudapi.cn"},
+		"queue":               {"privatelink.queue.core.chinacloudapi.cn"},
+		"queue_secondary":     {"privatelink.queue.core.chinacloudapi.cn"},
+		"redisCache":          {"privatelink.redis.cache.chinacloudapi.cn"},
+		"registry":            {"privatelink.azurecr.cn"},
+		"searchService":       {"privatelink.search.azure.cn"},
+		"signalr":             {"privatelink.signalr.azure.cn"},
+		"sites":               {"privatelink.chinacloudsites.cn"},
+		"Sql":                 {"privatelink.documents.azure.cn"},
+		"sqlServer":           {"privatelink.database.chinacloudapi.cn"},
+		"table":               {"privatelink.table.core.chinacloudapi.cn"},
+		"Table":               {"privatelink.table.cosmos.azure.cn"},
+		"table_secondary":     {"privatelink.table.core.chinacloudapi.cn"},
+		"topic":               {"privatelink.eventgrid.azure.cn"},
+		"vault":               {"privatelink.vaultcore.azure.cn"},
+		"web":                 {"privatelink.web.core.chinacloudapi.cn"},
+		"web_secondary":       {"privatelink.web.core.chinacloudapi.cn"},
+		"Webhook":             {"privatelink.azure-automation.cn"},
+	},
+	azure.USGovernmentCloud.Name: {
+		"amlworkspace":        {"privatelink.api.ml.azure.us", "privatelink.notebooks.usgovcloudapi.net"},
+		"batchAccount":        {"privatelink.batch.usgovcloudapi.net"},
+		"blob":                {"privatelink.blob.core.usgovcloudapi.net"},
+		"blob_secondary":      {"privatelink.blob.core.usgovcloudapi.net"},
+		"Cassandra":           {"privatelink.cassandra.cosmos.azure.us"},
+		"configurationStores": {"privatelink.azconfig.azure.us"},
+		"dataFactory":         {"privatelink.datafactory.azure.us"},
+		"dfs":                 {"privatelink.dfs.core.usgovcloudapi.net"},
+		"dfs_secondary":       {"privatelink.dfs.core.usgovcloudapi.net"},
+		"domain":              {"privatelink.eventgrid.azure.us"},
+		"DSCAndHybridWorker":  {"privatelink.azure-automation.us"},
+		"file":                {"privatelink.file.core.usgovcloudapi.net"},
+		"Gremlin":             {"privatelink.gremlin.cosmos.azure.us"},
+		"iotHub":              {"privatelink.azure-devices.us", "privatelink.servicebus.usgovcloudapi.net"},
+		"mariadbServer":       {"privatelink.mariadb.database.usgovcloudapi.net"},
+		"MongoDB":             {"privatelink.mongo.cosmos.azure.us"},
+		"mysqlServer":         {"privatelink.mysql.database.usgovcloudapi.net"},
+		"namespace":           {"privatelink.servicebus.usgovcloudapi.net"},
+		"portal":              {"privatelink.adf.azure.us"},
+		"postgresqlServer":    {"privatelink.postgres.database.usgovcloudapi.net"},
+		"queue":               {"privatelink.queue.core.usgovcloudapi.net"},
+		"queue_secondary":     {"privatelink.queue.core.usgovcloudapi.net"},
+		"redisCache":          {"privatelink.redis.cache.usgovcloudapi.net"},
+		"registry":            {"privatelink.azurecr.us"},
+		"searchService":       {"privatelink.search.windows.us"},
+		"signalr":             {"privatelink.signalr.azure.us"},
+		"sites":               {"privatelink.azurewebsites.us"},
+		"Sql":                 {"privatelink.documents.azure.us"},
+		"sqlServer":           {"privatelink.database.usgovcloudapi.net"},
+		"table":               {"privatelink.table.core.usgovcloudapi.net"},
+		"Table":               {"privatelink.table.cosmos.azure.us"},
+		"table_secondary":     {"privatelink.table.core.usgovcloudapi.net"},
+		"topic":               {"privatelink.eventgrid.azure.us"},
+		"vault":               {"privatelink.vaultcore.usgovcloudapi.net"},
+		"web":                 {"privatelink.web.core.usgovcloudapi.net"},
+		"web_secondary":       {"privatelink.web.core.usgovcloudapi.net"},
+		"Webhook":             {"privatelink.azure-automation.us"},
+	},
+}
+
+// privateEndpointDnsZoneNamesForSubresource returns the Private DNS Zone Names which should be used for the
+// specified Sub Resource Name within the specified Azure Environment
+func privateEndpointDnsZoneNamesForSubresource(environmentName string, subresourceName string) ([]string, error) {
+	catalog, ok := privateEndpointDnsZoneNamesForEnvironment[environmentName]
+	if !ok {
+		return nil, fmt.Errorf("the Private DNS Zone Names for Private Endpoints are not known for the Azure Environment %q", environmentName)
+	}
+
+	if zoneNames, ok := catalog[subresourceName]; ok {
+		return zoneNames, nil
+	}
+
+	// fall back to a case-insensitive match, providing this is unambiguous
+	matches := make([]string, 0)
+	for name := range catalog {
+		if strings.EqualFold(name, subresourceName) {
+			matches = append(matches, name)
+		}
+	}
+	if len(matches) == 1 {
+		return catalog[matches[0]], nil
+	}
+	if len(matches) > 1 {
+		sort.Strings(matches)
+		return nil, fmt.Errorf("the Sub Resource Name %q is ambiguous - please specify one of %s", subresourceName, strings.Join(matches, ", "))
+	}
+
+	return nil, fmt.Errorf("the Private DNS Zone Names for the Sub Resource Name %q are not known for the Azure Environment %q", subresourceName, environmentName)
+}
+
+// privateEndpointDnsZoneNamesForSubresources returns the unique Private DNS Zone Names which should be used for
+// the specified Sub Resource Names within the specified Azure Environment, in the order they're first referenced
+func privateEndpointDnsZoneNamesForSubresources(environmentName string, subresourceNames []string) ([]string, error) {
+	output := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, subresourceName := range subresourceNames {
+		zoneNames, err := privateEndpointDnsZoneNamesForSubresource(environmentName, subresourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zoneName := range zoneNames {
+			if _, ok := seen[zoneName]; ok {
+				continue
+			}
+			seen[zoneName] = struct{}{}
+			output = append(output, zoneName)
+		}
+	}
+
+	return output, nil
+}
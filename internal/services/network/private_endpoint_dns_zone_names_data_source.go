@@ -0,0 +1,102 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourcePrivateEndpointDnsZoneNames() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateEndpointDnsZoneNamesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"subresource_names": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.PrivateLinkSubResourceName,
+				},
+			},
+
+			"environment": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_dns_zone_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"subresource": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_dns_zone_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateEndpointDnsZoneNamesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	environmentName := meta.(*clients.Client).Account.Environment.Name
+	subresourceNames := *utils.ExpandStringSlice(d.Get("subresource_names").([]interface{}))
+
+	subresources := make([]interface{}, 0)
+	for _, subresourceName := range subresourceNames {
+		zoneNames, err := privateEndpointDnsZoneNamesForSubresource(environmentName, subresourceName)
+		if err != nil {
+			return err
+		}
+
+		subresources = append(subresources, map[string]interface{}{
+			"name":                   subresourceName,
+			"private_dns_zone_names": zoneNames,
+		})
+	}
+
+	zoneNames, err := privateEndpointDnsZoneNamesForSubresources(environmentName, subresourceNames)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("environment", environmentName)
+	if err := d.Set("private_dns_zone_names", zoneNames); err != nil {
+		return fmt.Errorf("setting `private_dns_zone_names`: %+v", err)
+	}
+	if err := d.Set("subresource", subresources); err != nil {
+		return fmt.Errorf("setting `subresource`: %+v", err)
+	}
+
+	return nil
+}
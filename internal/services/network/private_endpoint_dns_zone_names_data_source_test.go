@@ -0,0 +1,42 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsZoneNamesDataSource struct{}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("environment").Exists(),
+				check.That(data.ResourceName).Key("subresource.#").HasValue("3"),
+				check.That(data.ResourceName).Key("subresource.0.name").HasValue("blob"),
+				check.That(data.ResourceName).Key("subresource.0.private_dns_zone_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subresource.2.name").HasValue("blob_secondary"),
+				// `blob` and `blob_secondary` share a Private DNS Zone
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  subresource_names = ["blob", "vault", "blob_secondary"]
+}
+`
+}
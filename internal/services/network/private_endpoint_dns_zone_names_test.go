@@ -0,0 +1,144 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestPrivateEndpointDnsZoneNamesForSubresource(t *testing.T) {
+	testData := []struct {
+		Name            string
+		Environment     string
+		SubresourceName string
+		Expected        []string
+		ExpectError     bool
+	}{
+		{
+			Name:            "Exact Match",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "blob",
+			Expected:        []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name:            "Exact Match With Multiple Zones",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "iotHub",
+			Expected:        []string{"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+		},
+		{
+			Name:            "Exact Match Differing Only By Casing From Another",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "table",
+			Expected:        []string{"privatelink.table.core.windows.net"},
+		},
+		{
+			Name:            "Exact Match Differing Only By Casing From Another Uppercase",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "Table",
+			Expected:        []string{"privatelink.table.cosmos.azure.com"},
+		},
+		{
+			Name:            "Case Insensitive Match",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "MONGODB",
+			Expected:        []string{"privatelink.mongo.cosmos.azure.com"},
+		},
+		{
+			Name:            "Case Insensitive Match In Another Environment",
+			Environment:     azure.ChinaCloud.Name,
+			SubresourceName: "BLOB",
+			Expected:        []string{"privatelink.blob.core.chinacloudapi.cn"},
+		},
+		{
+			Name:            "Ambiguous Case Insensitive Match",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "TABLE",
+			ExpectError:     true,
+		},
+		{
+			Name:            "Unknown Sub Resource",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "doesNotExist",
+			ExpectError:     true,
+		},
+		{
+			Name:            "Empty Sub Resource",
+			Environment:     azure.PublicCloud.Name,
+			SubresourceName: "",
+			ExpectError:     true,
+		},
+		{
+			Name:            "Unknown Environment",
+			Environment:     "AzureUnknownCloud",
+			SubresourceName: "blob",
+			ExpectError:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := privateEndpointDnsZoneNamesForSubresource(v.Environment, v.SubresourceName)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointDnsZoneNamesForSubresources(t *testing.T) {
+	testData := []struct {
+		Name             string
+		SubresourceNames []string
+		Expected         []string
+		ExpectError      bool
+	}{
+		{
+			Name:             "None",
+			SubresourceNames: []string{},
+			Expected:         []string{},
+		},
+		{
+			Name:             "Duplicate Zones Are Removed",
+			SubresourceNames: []string{"blob", "blob_secondary", "table"},
+			Expected:         []string{"privatelink.blob.core.windows.net", "privatelink.table.core.windows.net"},
+		},
+		{
+			Name:             "Unknown Sub Resource",
+			SubresourceNames: []string{"blob", "doesNotExist"},
+			ExpectError:      true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := privateEndpointDnsZoneNamesForSubresources(azure.PublicCloud.Name, v.SubresourceNames)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
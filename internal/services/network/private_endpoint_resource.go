@@ -31,6 +31,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// privateEndpointAutoDnsZoneGroupName is the name of the Private DNS Zone Group created when using `auto_dns_zone_ids`
+const privateEndpointAutoDnsZoneGroupName = "default"
+
 func resourcePrivateEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePrivateEndpointCreate,
@@ -85,10 +88,21 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"auto_dns_zone_ids": {
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"private_dns_zone_group"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+				},
+			},
+
 			"private_dns_zone_group": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_dns_zone_ids"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -295,11 +309,15 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	ipConfigurations := d.Get("ip_configuration").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
 
+	privateDnsZoneGroup, err := expandPrivateEndpointDnsZoneGroup(d, meta.(*clients.Client).Account.Environment.Name)
+	if err != nil {
+		return fmt.Errorf("building the Private DNS Zone Group for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	parameters := network.PrivateEndpoint{
 		Location: utils.String(location),
 		PrivateEndpointProperties: &network.PrivateEndpointProperties{
@@ -444,11 +462,15 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	ipConfigurations := d.Get("ip_configuration").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
 
+	privateDnsZoneGroup, err := expandPrivateEndpointDnsZoneGroup(d, meta.(*clients.Client).Account.Environment.Name)
+	if err != nil {
+		return fmt.Errorf("building the Private DNS Zone Group for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	// TODO: in future it'd be nice to support conditional updates here, but one problem at a time
 	parameters := network.PrivateEndpoint{
 		Location: utils.String(location),
@@ -513,13 +535,13 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	// 1 Private Endpoint can have 1 Private DNS Zone Group - so to update we need to Delete & Recreate
-	if d.HasChange("private_dns_zone_group") {
+	if d.HasChanges("private_dns_zone_group", "auto_dns_zone_ids") {
 		existingDnsZoneGroups, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, dnsClient, *id)
 		if err != nil {
 			return err
 		}
 
-		newDnsZoneGroups := d.Get("private_dns_zone_group").([]interface{})
+		newDnsZoneGroups := privateDnsZoneGroup
 		newDnsZoneName := ""
 		if len(newDnsZoneGroups) > 0 {
 			groupRaw := newDnsZoneGroups[0].(map[string]interface{})
@@ -646,6 +668,11 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 			privateDnsZoneGroups = append(privateDnsZoneGroups, flattened.DnsZoneGroup)
 		}
 	}
+	// when the Private DNS Zone Group is built from `auto_dns_zone_ids` it's exposed via `private_dns_zone_configs` instead
+	if len(d.Get("auto_dns_zone_ids").(map[string]interface{})) > 0 {
+		privateDnsZoneGroups = make([]interface{}, 0)
+	}
+
 	if err := d.Set("private_dns_zone_configs", privateDnsZoneConfigs); err != nil {
 		return fmt.Errorf("setting `private_dns_zone_configs`: %+v", err)
 	}
@@ -959,6 +986,56 @@ func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *ne
 	return nil
 }
 
+// expandPrivateEndpointDnsZoneGroup returns the Private DNS Zone Group which should be associated with this Private
+// Endpoint - either as specified in `private_dns_zone_group`, or built from the Private DNS Zones within
+// `auto_dns_zone_ids` which are required by the `subresource_names` of the Private Service Connection
+func expandPrivateEndpointDnsZoneGroup(d *pluginsdk.ResourceData, environmentName string) ([]interface{}, error) {
+	autoDnsZoneIds := d.Get("auto_dns_zone_ids").(map[string]interface{})
+	if len(autoDnsZoneIds) == 0 {
+		return d.Get("private_dns_zone_group").([]interface{}), nil
+	}
+
+	subresourceNames := make([]string, 0)
+	for _, raw := range d.Get("private_service_connection").([]interface{}) {
+		connection := raw.(map[string]interface{})
+		subresourceNames = append(subresourceNames, *utils.ExpandStringSlice(connection["subresource_names"].([]interface{}))...)
+	}
+	if len(subresourceNames) == 0 {
+		return nil, fmt.Errorf("`subresource_names` must be specified within the `private_service_connection` block when using `auto_dns_zone_ids`")
+	}
+
+	zoneNames, err := privateEndpointDnsZoneNamesForSubresources(environmentName, subresourceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	privateDnsZoneIds := make([]interface{}, 0)
+	missing := make([]string, 0)
+	for _, zoneName := range zoneNames {
+		found := false
+		for k, v := range autoDnsZoneIds {
+			if strings.EqualFold(k, zoneName) {
+				privateDnsZoneIds = append(privateDnsZoneIds, v.(string))
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, zoneName)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("`auto_dns_zone_ids` is missing the ID of the Private DNS Zone(s) %q required by the Sub Resource Name(s) %q", strings.Join(missing, ", "), strings.Join(subresourceNames, ", "))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                 privateEndpointAutoDnsZoneGroupName,
+			"private_dns_zone_ids": privateDnsZoneIds,
+		},
+	}, nil
+}
+
 func deletePrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) error {
 	// lookup and delete the (should be, Single) Private DNS Zone Group associated with this Private Endpoint
 	privateDnsZoneIds, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, client, id)
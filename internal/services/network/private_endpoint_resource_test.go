@@ -118,6 +118,23 @@ func TestAccPrivateEndpoint_privateDnsZoneGroup(t *testing.T) {
 	})
 }
 
+func TestAccPrivateEndpoint_autoDnsZoneIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoDnsZoneIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_dns_zone_configs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_group.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("auto_dns_zone_ids", "private_dns_zone_configs", "private_dns_zone_group"),
+	})
+}
+
 func TestAccPrivateEndpoint_privateDnsZoneRename(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
 	r := PrivateEndpointResource{}
@@ -524,6 +541,79 @@ resource "azurerm_private_endpoint" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateEndpointResource) autoDnsZoneIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privatelink-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_postgresql_server" "test" {
+  name                = "acctest-pe-server-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "GP_Gen5_4"
+
+  storage_mb                   = 5120
+  backup_retention_days        = 7
+  geo_redundant_backup_enabled = false
+  auto_grow_enabled            = true
+
+  administrator_login          = "psqladmin"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "9.5"
+  ssl_enforcement_enabled      = true
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  subresource_names = ["postgresqlServer", "blob"]
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  for_each            = toset(data.azurerm_private_endpoint_dns_zone_names.test.private_dns_zone_names)
+  name                = each.value
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  auto_dns_zone_ids = { for k, v in azurerm_private_dns_zone.test : k => v.id }
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%[1]d"
+    private_connection_resource_id = azurerm_postgresql_server.test.id
+    subresource_names              = ["postgresqlServer"]
+    is_manual_connection           = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (PrivateEndpointResource) privateDnsZoneGroupRemove(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
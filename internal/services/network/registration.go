@@ -1,6 +1,7 @@
 package network
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -18,6 +19,23 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ManagerResource{},
+		ManagerAdminRuleResource{},
+		ManagerAdminRuleCollectionResource{},
+		ManagerConnectivityConfigurationResource{},
+		ManagerDeploymentResource{},
+		ManagerNetworkGroupResource{},
+		ManagerSecurityAdminConfigurationResource{},
+		ManagerStaticMemberResource{},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -30,7 +48,11 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface":                         dataSourceNetworkInterface(),
 		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
 		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
+		"azurerm_network_watcher_connectivity_check":        dataSourceNetworkWatcherConnectivityCheck(),
+		"azurerm_network_watcher_ip_flow_verify":            dataSourceNetworkWatcherIPFlowVerify(),
+		"azurerm_network_watcher_next_hop":                  dataSourceNetworkWatcherNextHop(),
 		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
+		"azurerm_private_endpoint_dns_zone_names":           dataSourcePrivateEndpointDnsZoneNames(),
 		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
 		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
 		"azurerm_public_ip":                                 dataSourcePublicIP(),
@@ -43,11 +65,17 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network_gateway_advertised_routes": dataSourceVirtualNetworkGatewayAdvertisedRoutes(),
+		"azurerm_virtual_network_gateway_bgp_peer_status":   dataSourceVirtualNetworkGatewayBgpPeerStatus(),
+		"azurerm_virtual_network_gateway_connection_health": dataSourceVirtualNetworkGatewayConnectionHealth(),
+		"azurerm_virtual_network_gateway_learned_routes":    dataSourceVirtualNetworkGatewayLearnedRoutes(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
 		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
 		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
 		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
 		"azurerm_vpn_gateway":                               dataSourceVPNGateway(),
+
+		"azurerm_virtual_network_available_address_prefixes": dataSourceVirtualNetworkAvailableAddressPrefixes(),
 	}
 }
 
@@ -55,6 +83,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_application_gateway":                      resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool": resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_http_listener":        resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_request_routing_rule": resourceApplicationGatewayRequestRoutingRule(),
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
@@ -96,6 +127,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_route_server":                              resourceRouteServer(),
 		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
+		"azurerm_subnet_address_allocation":                 resourceSubnetAddressAllocation(),
 		"azurerm_subnet_service_endpoint_storage_policy":    resourceSubnetServiceEndpointStoragePolicy(),
 		"azurerm_subnet_network_security_group_association": resourceSubnetNetworkSecurityGroupAssociation(),
 		"azurerm_subnet_route_table_association":            resourceSubnetRouteTableAssociation(),
@@ -121,5 +153,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_vpn_server_configuration_policy_group":     resourceVPNServerConfigurationPolicyGroup(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
+
+		"azurerm_web_application_firewall_policy_http_listener_association": resourceWebApplicationFirewallPolicyHTTPListenerAssociation(),
+		"azurerm_web_application_firewall_policy_path_rule_association":     resourceWebApplicationFirewallPolicyPathRuleAssociation(),
 	}
 }
@@ -0,0 +1,15 @@
+package adminrulecollections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AdminRuleCollectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAdminRuleCollectionsClientWithBaseURI(endpoint string) AdminRuleCollectionsClient {
+	return AdminRuleCollectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package adminrules
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/adminrules/%s", defaultApiVersion)
+}
@@ -0,0 +1,10 @@
+package connectivityconfigurations
+
+type ConnectivityConfiguration struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *ConnectivityConfigurationProperties `json:"properties,omitempty"`
+	SystemData *SystemData                          `json:"systemData,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
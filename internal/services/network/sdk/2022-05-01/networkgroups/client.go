@@ -0,0 +1,15 @@
+package networkgroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkGroupsClientWithBaseURI(endpoint string) NetworkGroupsClient {
+	return NetworkGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package networkgroups
+
+type NetworkGroupProperties struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networkgroups
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/networkgroups/%s", defaultApiVersion)
+}
@@ -0,0 +1,133 @@
+package networkmanagers
+
+import "strings"
+
+type ConfigurationType string
+
+const (
+	ConfigurationTypeConnectivity  ConfigurationType = "Connectivity"
+	ConfigurationTypeSecurityAdmin ConfigurationType = "SecurityAdmin"
+)
+
+func PossibleValuesForConfigurationType() []string {
+	return []string{
+		string(ConfigurationTypeConnectivity),
+		string(ConfigurationTypeSecurityAdmin),
+	}
+}
+
+func parseConfigurationType(input string) (*ConfigurationType, error) {
+	vals := map[string]ConfigurationType{
+		"connectivity":  ConfigurationTypeConnectivity,
+		"securityadmin": ConfigurationTypeSecurityAdmin,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConfigurationType(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusDeployed   DeploymentStatus = "Deployed"
+	DeploymentStatusDeploying  DeploymentStatus = "Deploying"
+	DeploymentStatusFailed     DeploymentStatus = "Failed"
+	DeploymentStatusNotStarted DeploymentStatus = "NotStarted"
+)
+
+func PossibleValuesForDeploymentStatus() []string {
+	return []string{
+		string(DeploymentStatusDeployed),
+		string(DeploymentStatusDeploying),
+		string(DeploymentStatusFailed),
+		string(DeploymentStatusNotStarted),
+	}
+}
+
+func parseDeploymentStatus(input string) (*DeploymentStatus, error) {
+	vals := map[string]DeploymentStatus{
+		"deployed":   DeploymentStatusDeployed,
+		"deploying":  DeploymentStatusDeploying,
+		"failed":     DeploymentStatusFailed,
+		"notstarted": DeploymentStatusNotStarted,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeploymentStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
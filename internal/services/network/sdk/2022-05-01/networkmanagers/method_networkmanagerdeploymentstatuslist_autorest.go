@@ -0,0 +1,67 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type NetworkManagerDeploymentStatusListOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerDeploymentStatusListResult
+}
+
+// NetworkManagerDeploymentStatusList ...
+func (c NetworkManagersClient) NetworkManagerDeploymentStatusList(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (result NetworkManagerDeploymentStatusListOperationResponse, err error) {
+	req, err := c.preparerForNetworkManagerDeploymentStatusList(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkManagerDeploymentStatusList", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkManagerDeploymentStatusList", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForNetworkManagerDeploymentStatusList(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkManagerDeploymentStatusList", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForNetworkManagerDeploymentStatusList prepares the NetworkManagerDeploymentStatusList request.
+func (c NetworkManagersClient) preparerForNetworkManagerDeploymentStatusList(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listDeploymentStatus", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForNetworkManagerDeploymentStatusList handles the response to the NetworkManagerDeploymentStatusList request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForNetworkManagerDeploymentStatusList(resp *http.Response) (result NetworkManagerDeploymentStatusListOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
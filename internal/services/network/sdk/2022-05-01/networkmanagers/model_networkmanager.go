@@ -0,0 +1,12 @@
+package networkmanagers
+
+type NetworkManager struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NetworkManagerProperties `json:"properties,omitempty"`
+	SystemData *SystemData               `json:"systemData,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
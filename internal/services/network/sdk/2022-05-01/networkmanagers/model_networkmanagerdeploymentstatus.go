@@ -0,0 +1,10 @@
+package networkmanagers
+
+type NetworkManagerDeploymentStatus struct {
+	CommitTime       *string            `json:"commitTime,omitempty"`
+	ConfigurationIds *[]string          `json:"configurationIds,omitempty"`
+	DeploymentStatus *DeploymentStatus  `json:"deploymentStatus,omitempty"`
+	DeploymentType   *ConfigurationType `json:"deploymentType,omitempty"`
+	ErrorMessage     *string            `json:"errorMessage,omitempty"`
+	Region           *string            `json:"region,omitempty"`
+}
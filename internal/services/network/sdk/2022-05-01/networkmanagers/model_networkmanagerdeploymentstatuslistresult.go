@@ -0,0 +1,6 @@
+package networkmanagers
+
+type NetworkManagerDeploymentStatusListResult struct {
+	SkipToken *string                           `json:"skipToken,omitempty"`
+	Value     *[]NetworkManagerDeploymentStatus `json:"value,omitempty"`
+}
@@ -0,0 +1,15 @@
+package securityadminconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SecurityAdminConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSecurityAdminConfigurationsClientWithBaseURI(endpoint string) SecurityAdminConfigurationsClient {
+	return SecurityAdminConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
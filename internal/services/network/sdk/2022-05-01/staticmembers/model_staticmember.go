@@ -0,0 +1,9 @@
+package staticmembers
+
+type StaticMember struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *StaticMemberProperties `json:"properties,omitempty"`
+	SystemData *SystemData             `json:"systemData,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,226 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSubnetAddressAllocation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSubnetAddressAllocationCreate,
+		Read:   resourceSubnetAddressAllocationRead,
+		Delete: resourceSubnetAddressAllocationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			parsed, err := parse.SubnetAddressAllocationID(id)
+			if err != nil {
+				return err
+			}
+			_, err = subnetAddressAllocationPrefix(*parsed)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+			},
+
+			"prefix_length": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 29),
+			},
+
+			"exclude_address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(subnetAddressAllocationCustomizeDiff),
+	}
+}
+
+// subnetAddressAllocationCustomizeDiff allocates the address prefix during the plan, so that it's known when planning
+// the Subnet which uses it - this is deterministic since it only depends on the existing Subnets and the configuration
+func subnetAddressAllocationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("virtual_network_id", "prefix_length", "exclude_address_prefixes") {
+		return nil
+	}
+
+	// when the Virtual Network or the excluded prefixes aren't known yet (e.g. they're created in the same apply)
+	// the address prefix is allocated during creation instead
+	if !d.NewValueKnown("virtual_network_id") || !d.NewValueKnown("exclude_address_prefixes.#") {
+		return d.SetNewComputed("address_prefix")
+	}
+	excludedPrefixes := d.Get("exclude_address_prefixes").([]interface{})
+	for i := range excludedPrefixes {
+		if !d.NewValueKnown(fmt.Sprintf("exclude_address_prefixes.%d", i)) {
+			return d.SetNewComputed("address_prefix")
+		}
+	}
+
+	client := meta.(*clients.Client).Network.VnetClient
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	vnet, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			return d.SetNewComputed("address_prefix")
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	addressSpaces, usedPrefixes := virtualNetworkAddressPrefixes(vnet)
+	usedPrefixes = append(usedPrefixes, *utils.ExpandStringSlice(excludedPrefixes)...)
+
+	prefix, err := nextAvailableAddressPrefix(addressSpaces, usedPrefixes, d.Get("prefix_length").(int))
+	if err != nil {
+		return fmt.Errorf("allocating an address prefix within %s: %+v", *id, err)
+	}
+
+	return d.SetNew("address_prefix", prefix)
+}
+
+func resourceSubnetAddressAllocationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vnetId, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(vnetId.Name, VirtualNetworkResourceName)
+	defer locks.UnlockByName(vnetId.Name, VirtualNetworkResourceName)
+
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			return fmt.Errorf("%s was not found", *vnetId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *vnetId, err)
+	}
+
+	addressSpaces, usedPrefixes := virtualNetworkAddressPrefixes(vnet)
+	usedPrefixes = append(usedPrefixes, *utils.ExpandStringSlice(d.Get("exclude_address_prefixes").([]interface{}))...)
+
+	// the address prefix will have been allocated during the plan unless the Virtual Network wasn't known at the time
+	prefix := d.Get("address_prefix").(string)
+	if prefix == "" {
+		prefix, err = nextAvailableAddressPrefix(addressSpaces, usedPrefixes, d.Get("prefix_length").(int))
+		if err != nil {
+			return fmt.Errorf("allocating an address prefix within %s: %+v", *vnetId, err)
+		}
+	} else {
+		overlap, err := addressPrefixOverlaps(prefix, usedPrefixes)
+		if err != nil {
+			return err
+		}
+		if overlap != nil {
+			return fmt.Errorf("the address prefix %q allocated during the plan overlaps the address prefix %q which has since been used within %s - please re-run the plan", prefix, *overlap, *vnetId)
+		}
+	}
+
+	id := parse.NewSubnetAddressAllocationID(vnetId.SubscriptionId, vnetId.ResourceGroup, vnetId.Name, subnetAddressAllocationName(prefix))
+	d.SetId(id.ID())
+	return resourceSubnetAddressAllocationRead(d, meta)
+}
+
+func resourceSubnetAddressAllocationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubnetAddressAllocationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	prefix, err := subnetAddressAllocationPrefix(*id)
+	if err != nil {
+		return err
+	}
+
+	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", vnetId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", vnetId, err)
+	}
+
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", prefix, err)
+	}
+	prefixLength, _ := network.Mask.Size()
+
+	d.Set("virtual_network_id", vnetId.ID())
+	d.Set("prefix_length", prefixLength)
+	d.Set("address_prefix", prefix)
+
+	return nil
+}
+
+func resourceSubnetAddressAllocationDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// the allocation only exists within the state - any Subnet using the address prefix is managed separately
+	return nil
+}
+
+// subnetAddressAllocationName returns the name used for the allocation within the Resource ID - since the address
+// prefix contains a `/` this is replaced with a `_`, for example `10.0.1.0/24` becomes `10.0.1.0_24`
+func subnetAddressAllocationName(prefix string) string {
+	return strings.Replace(prefix, "/", "_", 1)
+}
+
+func subnetAddressAllocationPrefix(id parse.SubnetAddressAllocationId) (string, error) {
+	prefix := strings.Replace(id.AddressAllocationName, "_", "/", 1)
+	if _, errs := validate.CIDR(prefix, "address_prefix"); len(errs) > 0 {
+		return "", fmt.Errorf("expected the Address Allocation Name to be an address prefix in the format `10.0.1.0_24` but got %q", id.AddressAllocationName)
+	}
+
+	return prefix, nil
+}
@@ -0,0 +1,151 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SubnetAddressAllocationResource struct{}
+
+func TestAccSubnetAddressAllocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_address_allocation", "test")
+	r := SubnetAddressAllocationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefix").HasValue("10.0.1.0/24"),
+				check.That("azurerm_subnet.test").Key("address_prefixes.0").HasValue("10.0.1.0/24"),
+			),
+		},
+		data.ImportStep("exclude_address_prefixes"),
+	})
+}
+
+func TestAccSubnetAddressAllocation_chained(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_address_allocation", "test")
+	r := SubnetAddressAllocationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.chained(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefix").HasValue("10.0.1.0/24"),
+				check.That("azurerm_subnet_address_allocation.second").Key("address_prefix").HasValue("10.0.2.0/23"),
+				check.That("azurerm_subnet.second").Key("address_prefixes.0").HasValue("10.0.2.0/23"),
+			),
+		},
+		data.ImportStep("exclude_address_prefixes"),
+	})
+}
+
+func (SubnetAddressAllocationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	allocationId, err := parse.SubnetAddressAllocationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	id := parse.NewVirtualNetworkID(allocationId.SubscriptionId, allocationId.ResourceGroup, allocationId.VirtualNetworkName)
+
+	// the allocation only exists within the state, so it exists for as long as the Virtual Network does
+	resp, err := clients.Network.VnetClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (SubnetAddressAllocationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "existing" {
+  name                 = "existing"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/26"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r SubnetAddressAllocationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_address_allocation" "test" {
+  virtual_network_id       = azurerm_virtual_network.test.id
+  prefix_length            = 24
+  exclude_address_prefixes = azurerm_subnet.existing.address_prefixes
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [azurerm_subnet_address_allocation.test.address_prefix]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SubnetAddressAllocationResource) chained(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_address_allocation" "test" {
+  virtual_network_id       = azurerm_virtual_network.test.id
+  prefix_length            = 24
+  exclude_address_prefixes = azurerm_subnet.existing.address_prefixes
+}
+
+resource "azurerm_subnet_address_allocation" "second" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_length      = 23
+  exclude_address_prefixes = concat(
+    azurerm_subnet.existing.address_prefixes,
+    [azurerm_subnet_address_allocation.test.address_prefix],
+  )
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [azurerm_subnet_address_allocation.test.address_prefix]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "acctestsubnet2%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [azurerm_subnet_address_allocation.second.address_prefix]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
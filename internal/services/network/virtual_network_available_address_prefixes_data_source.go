@@ -0,0 +1,118 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualNetworkAvailableAddressPrefixes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkAvailableAddressPrefixesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"used_address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkAvailableAddressPrefixesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	addressSpaces, usedPrefixes := virtualNetworkAddressPrefixes(resp)
+	available, err := availableAddressPrefixes(addressSpaces, usedPrefixes)
+	if err != nil {
+		return fmt.Errorf("determining the available address prefixes for %s: %+v", *id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/availableAddressPrefixes", id.ID()))
+
+	d.Set("virtual_network_id", id.ID())
+	if err := d.Set("address_prefixes", available); err != nil {
+		return fmt.Errorf("setting `address_prefixes`: %+v", err)
+	}
+	if err := d.Set("used_address_prefixes", usedPrefixes); err != nil {
+		return fmt.Errorf("setting `used_address_prefixes`: %+v", err)
+	}
+
+	return nil
+}
+
+// virtualNetworkAddressPrefixes returns the address space of the Virtual Network and the address prefixes used by
+// the Subnets within it
+func virtualNetworkAddressPrefixes(input network.VirtualNetwork) ([]string, []string) {
+	addressSpaces := make([]string, 0)
+	usedPrefixes := make([]string, 0)
+
+	props := input.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return addressSpaces, usedPrefixes
+	}
+
+	if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+		addressSpaces = append(addressSpaces, *props.AddressSpace.AddressPrefixes...)
+	}
+
+	if props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			subnetProps := subnet.SubnetPropertiesFormat
+			if subnetProps == nil {
+				continue
+			}
+
+			if subnetProps.AddressPrefixes != nil && len(*subnetProps.AddressPrefixes) > 0 {
+				usedPrefixes = append(usedPrefixes, *subnetProps.AddressPrefixes...)
+			} else if subnetProps.AddressPrefix != nil {
+				usedPrefixes = append(usedPrefixes, *subnetProps.AddressPrefix)
+			}
+		}
+	}
+
+	return addressSpaces, usedPrefixes
+}
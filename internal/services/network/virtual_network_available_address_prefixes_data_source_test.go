@@ -0,0 +1,69 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkAvailableAddressPrefixesDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkAvailableAddressPrefixes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_address_prefixes", "test")
+	r := VirtualNetworkAvailableAddressPrefixesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("used_address_prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("3"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.0.128/25"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.2.0/23"),
+				check.That(data.ResourceName).Key("address_prefixes.2").HasValue("10.1.0.0/24"),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkAvailableAddressPrefixesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/22", "10.1.0.0/24"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/25"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+data "azurerm_virtual_network_available_address_prefixes" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
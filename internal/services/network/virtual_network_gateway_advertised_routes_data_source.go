@@ -0,0 +1,74 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualNetworkGatewayAdvertisedRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayAdvertisedRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"peer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"route": virtualNetworkGatewayRouteSchema(),
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayAdvertisedRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	peer := d.Get("peer").(string)
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.GetAdvertisedRoutes(ctx, gatewayId.ResourceGroup, gatewayId.Name, peer)
+	if err != nil {
+		return fmt.Errorf("retrieving Advertised Routes to Peer %q for %s: %+v", peer, *gatewayId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for retrieval of Advertised Routes to Peer %q for %s: %+v", peer, *gatewayId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Advertised Routes to Peer %q for %s: %+v", peer, *gatewayId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/advertisedRoutes/%s", gatewayId.ID(), peer))
+
+	d.Set("virtual_network_gateway_id", gatewayId.ID())
+	d.Set("peer", peer)
+	if err := d.Set("route", flattenVirtualNetworkGatewayRoutes(result.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayAdvertisedRoutesDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkGatewayAdvertisedRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_advertised_routes", "test")
+	r := VirtualNetworkGatewayAdvertisedRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peer").HasValue("10.1.1.254"),
+				check.That(data.ResourceName).Key("route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayAdvertisedRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_advertised_routes" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+  peer                       = azurerm_local_network_gateway.test.bgp_settings.0.bgp_peering_address
+}
+`, VirtualNetworkGatewayBgpPeerStatusDataSource{}.template(data))
+}
@@ -0,0 +1,182 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualNetworkGatewayBgpPeerStatus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayBgpPeerStatusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"peer": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"bgp_peer": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"asn": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"connected_duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"local_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"messages_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"messages_sent": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"neighbor": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"routes_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayBgpPeerStatusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	peer := d.Get("peer").(string)
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.GetBgpPeerStatus(ctx, gatewayId.ResourceGroup, gatewayId.Name, peer)
+	if err != nil {
+		return fmt.Errorf("retrieving BGP Peer Status for %s: %+v", *gatewayId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for retrieval of BGP Peer Status for %s: %+v", *gatewayId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving BGP Peer Status for %s: %+v", *gatewayId, err)
+	}
+
+	id := fmt.Sprintf("%s/bgpPeerStatus", gatewayId.ID())
+	if peer != "" {
+		id = fmt.Sprintf("%s/%s", id, peer)
+	}
+	d.SetId(id)
+
+	d.Set("virtual_network_gateway_id", gatewayId.ID())
+	d.Set("peer", peer)
+	if err := d.Set("bgp_peer", flattenVirtualNetworkGatewayBgpPeerStatus(result.Value)); err != nil {
+		return fmt.Errorf("setting `bgp_peer`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkGatewayBgpPeerStatus(input *[]network.BgpPeerStatus) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		asn := 0
+		if v.Asn != nil {
+			asn = int(*v.Asn)
+		}
+
+		connectedDuration := ""
+		if v.ConnectedDuration != nil {
+			connectedDuration = *v.ConnectedDuration
+		}
+
+		localAddress := ""
+		if v.LocalAddress != nil {
+			localAddress = *v.LocalAddress
+		}
+
+		messagesReceived := 0
+		if v.MessagesReceived != nil {
+			messagesReceived = int(*v.MessagesReceived)
+		}
+
+		messagesSent := 0
+		if v.MessagesSent != nil {
+			messagesSent = int(*v.MessagesSent)
+		}
+
+		neighbor := ""
+		if v.Neighbor != nil {
+			neighbor = *v.Neighbor
+		}
+
+		routesReceived := 0
+		if v.RoutesReceived != nil {
+			routesReceived = int(*v.RoutesReceived)
+		}
+
+		output = append(output, map[string]interface{}{
+			"asn":                asn,
+			"connected_duration": connectedDuration,
+			"local_address":      localAddress,
+			"messages_received":  messagesReceived,
+			"messages_sent":      messagesSent,
+			"neighbor":           neighbor,
+			"routes_received":    routesReceived,
+			"state":              string(v.State),
+		})
+	}
+
+	return output
+}
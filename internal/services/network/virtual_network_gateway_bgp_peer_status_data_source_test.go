@@ -0,0 +1,142 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayBgpPeerStatusDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkGatewayBgpPeerStatus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_peer_status", "test")
+	r := VirtualNetworkGatewayBgpPeerStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("bgp_peer.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualNetworkGatewayBgpPeerStatus_peer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_peer_status", "test")
+	r := VirtualNetworkGatewayBgpPeerStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.peer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("bgp_peer.#").HasValue("1"),
+				check.That(data.ResourceName).Key("bgp_peer.0.neighbor").HasValue("10.1.1.254"),
+				check.That(data.ResourceName).Key("bgp_peer.0.state").Exists(),
+			),
+		},
+	})
+}
+
+func (r VirtualNetworkGatewayBgpPeerStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_peer_status" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+}
+`, r.template(data))
+}
+
+func (r VirtualNetworkGatewayBgpPeerStatusDataSource) peer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_peer_status" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+  peer                       = azurerm_local_network_gateway.test.bgp_settings.0.bgp_peering_address
+}
+`, r.template(data))
+}
+
+// template provisions a BGP enabled Virtual Network Gateway with a Site-to-Site connection to a BGP peer, which is
+// shared by the Virtual Network Gateway diagnostics Data Sources
+func (VirtualNetworkGatewayBgpPeerStatusDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  type       = "Vpn"
+  vpn_type   = "RouteBased"
+  sku        = "VpnGw1"
+  enable_bgp = true
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.test.id
+  }
+}
+
+resource "azurerm_local_network_gateway" "test" {
+  name                = "acctestlng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  gateway_address = "168.62.225.23"
+  address_space   = ["10.1.1.0/24"]
+
+  bgp_settings {
+    asn                 = 65001
+    bgp_peering_address = "10.1.1.254"
+  }
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+  name                = "acctestvngc-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurerm_local_network_gateway.test.id
+  enable_bgp                 = true
+
+  shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,164 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualNetworkGatewayConnectionHealth() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayConnectionHealthRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkGatewayConnectionID,
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"egress_bytes_transferred": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"ingress_bytes_transferred": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"tunnel": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"connection_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"egress_bytes_transferred": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"ingress_bytes_transferred": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"last_connection_established_utc_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayConnectionHealthRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkGatewayConnectionID(d.Get("virtual_network_gateway_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/health", id.ID()))
+
+	d.Set("virtual_network_gateway_connection_id", id.ID())
+
+	if props := resp.VirtualNetworkGatewayConnectionPropertiesFormat; props != nil {
+		d.Set("connection_status", string(props.ConnectionStatus))
+
+		egressBytesTransferred := 0
+		if props.EgressBytesTransferred != nil {
+			egressBytesTransferred = int(*props.EgressBytesTransferred)
+		}
+		d.Set("egress_bytes_transferred", egressBytesTransferred)
+
+		ingressBytesTransferred := 0
+		if props.IngressBytesTransferred != nil {
+			ingressBytesTransferred = int(*props.IngressBytesTransferred)
+		}
+		d.Set("ingress_bytes_transferred", ingressBytesTransferred)
+
+		if err := d.Set("tunnel", flattenVirtualNetworkGatewayConnectionTunnelHealth(props.TunnelConnectionStatus)); err != nil {
+			return fmt.Errorf("setting `tunnel`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkGatewayConnectionTunnelHealth(input *[]network.TunnelConnectionHealth) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Tunnel != nil {
+			name = *v.Tunnel
+		}
+
+		egressBytesTransferred := 0
+		if v.EgressBytesTransferred != nil {
+			egressBytesTransferred = int(*v.EgressBytesTransferred)
+		}
+
+		ingressBytesTransferred := 0
+		if v.IngressBytesTransferred != nil {
+			ingressBytesTransferred = int(*v.IngressBytesTransferred)
+		}
+
+		lastConnectionEstablishedUtcTime := ""
+		if v.LastConnectionEstablishedUtcTime != nil {
+			lastConnectionEstablishedUtcTime = *v.LastConnectionEstablishedUtcTime
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                                 name,
+			"connection_status":                    string(v.ConnectionStatus),
+			"egress_bytes_transferred":             egressBytesTransferred,
+			"ingress_bytes_transferred":            ingressBytesTransferred,
+			"last_connection_established_utc_time": lastConnectionEstablishedUtcTime,
+		})
+	}
+
+	return output
+}
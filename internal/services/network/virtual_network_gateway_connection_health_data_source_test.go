@@ -0,0 +1,38 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayConnectionHealthDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkGatewayConnectionHealth_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_connection_health", "test")
+	r := VirtualNetworkGatewayConnectionHealthDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("egress_bytes_transferred").Exists(),
+				check.That(data.ResourceName).Key("ingress_bytes_transferred").Exists(),
+				check.That(data.ResourceName).Key("tunnel.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayConnectionHealthDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_connection_health" "test" {
+  virtual_network_gateway_connection_id = azurerm_virtual_network_gateway_connection.test.id
+}
+`, VirtualNetworkGatewayBgpPeerStatusDataSource{}.template(data))
+}
@@ -0,0 +1,167 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualNetworkGatewayLearnedRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayLearnedRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"route": virtualNetworkGatewayRouteSchema(),
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayLearnedRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// this is a POST which is a Long Running Operation, rather than a GET
+	future, err := client.GetLearnedRoutes(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Learned Routes for %s: %+v", *gatewayId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for retrieval of Learned Routes for %s: %+v", *gatewayId, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Learned Routes for %s: %+v", *gatewayId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/learnedRoutes", gatewayId.ID()))
+
+	d.Set("virtual_network_gateway_id", gatewayId.ID())
+	if err := d.Set("route", flattenVirtualNetworkGatewayRoutes(result.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func virtualNetworkGatewayRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenVirtualNetworkGatewayRoutes(input *[]network.GatewayRoute) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		asPath := ""
+		if v.AsPath != nil {
+			asPath = *v.AsPath
+		}
+
+		localAddress := ""
+		if v.LocalAddress != nil {
+			localAddress = *v.LocalAddress
+		}
+
+		networkPrefix := ""
+		if v.NetworkProperty != nil {
+			networkPrefix = *v.NetworkProperty
+		}
+
+		nextHop := ""
+		if v.NextHop != nil {
+			nextHop = *v.NextHop
+		}
+
+		origin := ""
+		if v.Origin != nil {
+			origin = *v.Origin
+		}
+
+		sourcePeer := ""
+		if v.SourcePeer != nil {
+			sourcePeer = *v.SourcePeer
+		}
+
+		weight := 0
+		if v.Weight != nil {
+			weight = int(*v.Weight)
+		}
+
+		output = append(output, map[string]interface{}{
+			"as_path":       asPath,
+			"local_address": localAddress,
+			"network":       networkPrefix,
+			"next_hop":      nextHop,
+			"origin":        origin,
+			"source_peer":   sourcePeer,
+			"weight":        weight,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,37 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayLearnedRoutesDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkGatewayLearnedRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_learned_routes", "test")
+	r := VirtualNetworkGatewayLearnedRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the Virtual Network Gateway always learns the routes for its own Virtual Network
+				check.That(data.ResourceName).Key("route.#").Exists(),
+				check.That(data.ResourceName).Key("route.0.network").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayLearnedRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_learned_routes" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+}
+`, VirtualNetworkGatewayBgpPeerStatusDataSource{}.template(data))
+}
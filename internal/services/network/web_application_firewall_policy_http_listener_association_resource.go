@@ -0,0 +1,181 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceWebApplicationFirewallPolicyHTTPListenerAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebApplicationFirewallPolicyHTTPListenerAssociationCreateUpdate,
+		Read:   resourceWebApplicationFirewallPolicyHTTPListenerAssociationRead,
+		Update: resourceWebApplicationFirewallPolicyHTTPListenerAssociationCreateUpdate,
+		Delete: resourceWebApplicationFirewallPolicyHTTPListenerAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayHTTPListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"http_listener_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayHTTPListenerID,
+			},
+
+			"web_application_firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
+			},
+		},
+	}
+}
+
+func resourceWebApplicationFirewallPolicyHTTPListenerAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Get("http_listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("%s was not found", gatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway.ApplicationGatewayPropertiesFormat, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil {
+		return fmt.Errorf("%s was not found", *id)
+	}
+
+	if d.IsNewResource() && listener.FirewallPolicy != nil && listener.FirewallPolicy.ID != nil && *listener.FirewallPolicy.ID != "" {
+		return tf.ImportAsExistsError("azurerm_web_application_firewall_policy_http_listener_association", id.ID())
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	for _, existing := range *gateway.HTTPListeners {
+		if existing.Name != nil && *existing.Name == *listener.Name {
+			existing.FirewallPolicy = &network.SubResource{
+				ID: utils.String(d.Get("web_application_firewall_policy_id").(string)),
+			}
+		}
+		listeners = append(listeners, existing)
+	}
+	gateway.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayChildResource(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("associating Web Application Firewall Policy with %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebApplicationFirewallPolicyHTTPListenerAssociationRead(d, meta)
+}
+
+func resourceWebApplicationFirewallPolicyHTTPListenerAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway.ApplicationGatewayPropertiesFormat, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil || listener.FirewallPolicy == nil || listener.FirewallPolicy.ID == nil {
+		log.Printf("[DEBUG] Web Application Firewall Policy Association for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("http_listener_id", id.ID())
+	d.Set("web_application_firewall_policy_id", *listener.FirewallPolicy.ID)
+
+	return nil
+}
+
+func resourceWebApplicationFirewallPolicyHTTPListenerAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway.ApplicationGatewayPropertiesFormat, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil {
+		return nil
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	for _, existing := range *gateway.HTTPListeners {
+		if existing.Name != nil && *existing.Name == *listener.Name {
+			existing.FirewallPolicy = nil
+		}
+		listeners = append(listeners, existing)
+	}
+	gateway.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayChildResource(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("removing Web Application Firewall Policy Association from %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebApplicationFirewallPolicyPathRuleAssociationResource struct{}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (WebApplicationFirewallPolicyPathRuleAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayURLPathMapPathRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.URLPathMaps != nil {
+		for _, pathMap := range *props.URLPathMaps {
+			if pathMap.Name == nil || !strings.EqualFold(*pathMap.Name, id.UrlPathMapName) {
+				continue
+			}
+			if pathMap.ApplicationGatewayURLPathMapPropertiesFormat == nil || pathMap.PathRules == nil {
+				continue
+			}
+			for _, rule := range *pathMap.PathRules {
+				if rule.Name == nil || !strings.EqualFold(*rule.Name, id.PathRuleName) {
+					continue
+				}
+				if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil && ruleProps.FirewallPolicy != nil {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r WebApplicationFirewallPolicyPathRuleAssociationResource) basic(data acceptance.TestData, policy string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy_path_rule_association" "test" {
+  path_rule_id                       = "${azurerm_application_gateway.test.id}/urlPathMaps/${local.url_path_map_name}/pathRules/${local.path_rule_name}"
+  web_application_firewall_policy_id = azurerm_web_application_firewall_policy.%s.id
+}
+`, WebApplicationFirewallPolicyHTTPListenerAssociationResource{}.template(data), policy)
+}
+
+func (r WebApplicationFirewallPolicyPathRuleAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy_path_rule_association" "import" {
+  path_rule_id                       = azurerm_web_application_firewall_policy_path_rule_association.test.path_rule_id
+  web_application_firewall_policy_id = azurerm_web_application_firewall_policy_path_rule_association.test.web_application_firewall_policy_id
+}
+`, r.basic(data, "first"))
+}
@@ -14,8 +14,9 @@ import (
 	flexibleserverdatabases "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/databases"
 	flexibleserverfirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/firewallrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
-	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	flexibleserveradministrators "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/administrators"
+	flexibleservers "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 )
 
 type Client struct {
@@ -23,6 +24,7 @@ type Client struct {
 	DatabasesClient                     *databases.DatabasesClient
 	FirewallRulesClient                 *firewallrules.FirewallRulesClient
 	FlexibleServersClient               *flexibleservers.ServersClient
+	FlexibleServerAdministratorsClient  *flexibleserveradministrators.AdministratorsClient
 	FlexibleServersConfigurationsClient *flexibleserverconfigurations.ConfigurationsClient
 	FlexibleServerFirewallRuleClient    *flexibleserverfirewallrules.FirewallRulesClient
 	FlexibleServerDatabaseClient        *flexibleserverdatabases.DatabasesClient
@@ -66,6 +68,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServersClient := flexibleservers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&flexibleServersClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerAdministratorsClient := flexibleserveradministrators.NewAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
 	restartServerClient := serverrestart.NewServerRestartClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&restartServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -84,6 +89,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		FirewallRulesClient:                 &firewallRulesClient,
 		FlexibleServersConfigurationsClient: &flexibleServerConfigurationsClient,
 		FlexibleServersClient:               &flexibleServersClient,
+		FlexibleServerAdministratorsClient:  &flexibleServerAdministratorsClient,
 		ServerRestartClient:                 &restartServerClient,
 		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
@@ -11,12 +11,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -107,7 +107,9 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(servers.CreateModeDefault),
+					string(servers.CreateModeGeoRestore),
 					string(servers.CreateModePointInTimeRestore),
+					string(servers.CreateModeReplica),
 				}, false),
 			},
 
@@ -143,6 +145,44 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: servers.ValidateFlexibleServerID,
 			},
 
+			"replication_role": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationRoleNone),
+				}, false),
+			},
+
+			"authentication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"active_directory_auth_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"password_auth_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"tenant_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
 			"maintenance_window": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -244,21 +284,35 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	createMode := d.Get("create_mode").(string)
 
-	if servers.CreateMode(createMode) == servers.CreateModePointInTimeRestore {
+	if _, ok := d.GetOk("replication_role"); ok {
+		return fmt.Errorf("`replication_role` cannot be set while creating")
+	}
+
+	switch servers.CreateMode(createMode) {
+	case servers.CreateModePointInTimeRestore, servers.CreateModeGeoRestore:
 		if _, ok := d.GetOk("source_server_id"); !ok {
-			return fmt.Errorf("`source_server_id` is required when `create_mode` is `PointInTimeRestore`")
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `%s`", createMode)
 		}
 		if _, ok := d.GetOk("point_in_time_restore_time_in_utc"); !ok {
-			return fmt.Errorf("`point_in_time_restore_time_in_utc` is required when `create_mode` is `PointInTimeRestore`")
+			return fmt.Errorf("`point_in_time_restore_time_in_utc` is required when `create_mode` is `%s`", createMode)
+		}
+	case servers.CreateModeReplica:
+		if _, ok := d.GetOk("source_server_id"); !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `Replica`")
 		}
 	}
 
+	authConfig := expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{}), meta.(*clients.Client).Account.TenantId)
+	passwordAuthEnabled := authConfig == nil || authConfig.PasswordAuth == nil || *authConfig.PasswordAuth == servers.PasswordAuthEnumEnabled
+
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
-		if _, ok := d.GetOk("administrator_login"); !ok {
-			return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default`")
-		}
-		if _, ok := d.GetOk("administrator_password"); !ok {
-			return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default`")
+		if passwordAuthEnabled {
+			if _, ok := d.GetOk("administrator_login"); !ok {
+				return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default` and `authentication.0.password_auth_enabled` is `true`")
+			}
+			if _, ok := d.GetOk("administrator_password"); !ok {
+				return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default` and `authentication.0.password_auth_enabled` is `true`")
+			}
 		}
 		if _, ok := d.GetOk("sku_name"); !ok {
 			return fmt.Errorf("`sku_name` is required when `create_mode` is `Default`")
@@ -283,6 +337,7 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 			Storage:          expandArmServerStorage(d),
 			HighAvailability: expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), true),
 			Backup:           expandArmServerBackup(d),
+			AuthConfig:       authConfig,
 		},
 		Sku:  sku,
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -376,6 +431,16 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("version", props.Version)
 			d.Set("fqdn", props.FullyQualifiedDomainName)
 
+			replicationRole := ""
+			if props.ReplicationRole != nil {
+				replicationRole = string(*props.ReplicationRole)
+			}
+			d.Set("replication_role", replicationRole)
+
+			if err := d.Set("authentication", flattenFlexibleServerAuthConfig(props.AuthConfig)); err != nil {
+				return fmt.Errorf("setting `authentication`: %+v", err)
+			}
+
 			if network := props.Network; network != nil {
 				publicNetworkAccess := false
 				if network.PublicNetworkAccess != nil {
@@ -433,6 +498,25 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	// a read replica has to be promoted to a standalone server before any other property can be updated
+	if d.HasChange("replication_role") {
+		oldReplicationRole, newReplicationRole := d.GetChange("replication_role")
+		isReplica := oldReplicationRole.(string) == string(servers.ReplicationRoleAsyncReplica) || oldReplicationRole.(string) == string(servers.ReplicationRoleGeoAsyncReplica)
+		if !isReplica || newReplicationRole.(string) != string(servers.ReplicationRoleNone) {
+			return fmt.Errorf("`replication_role` can only be updated from `AsyncReplica` or `GeoAsyncReplica` to `None`")
+		}
+
+		replicationRole := servers.ReplicationRoleNone
+		promoteParameters := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				ReplicationRole: &replicationRole,
+			},
+		}
+		if err = client.UpdateThenPoll(ctx, *id, promoteParameters); err != nil {
+			return fmt.Errorf("promoting %s: %+v", *id, err)
+		}
+	}
+
 	parameters := servers.ServerForUpdate{
 		Location:   utils.String(location.Normalize(d.Get("location").(string))),
 		Properties: &servers.ServerPropertiesForUpdate{},
@@ -484,6 +568,10 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
 	}
 
+	if d.HasChange("authentication") {
+		parameters.Properties.AuthConfig = expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{}), meta.(*clients.Client).Account.TenantId)
+	}
+
 	if d.HasChange("storage_mb") {
 		parameters.Properties.Storage = expandArmServerStorage(d)
 	}
@@ -722,3 +810,61 @@ func flattenFlexibleServerHighAvailability(ha *servers.HighAvailability) []inter
 		},
 	}
 }
+
+func expandFlexibleServerAuthConfig(input []interface{}, defaultTenantId string) *servers.AuthConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	activeDirectoryAuth := servers.ActiveDirectoryAuthEnumDisabled
+	if v["active_directory_auth_enabled"].(bool) {
+		activeDirectoryAuth = servers.ActiveDirectoryAuthEnumEnabled
+	}
+
+	passwordAuth := servers.PasswordAuthEnumDisabled
+	if v["password_auth_enabled"].(bool) {
+		passwordAuth = servers.PasswordAuthEnumEnabled
+	}
+
+	authConfig := servers.AuthConfig{
+		ActiveDirectoryAuth: &activeDirectoryAuth,
+		PasswordAuth:        &passwordAuth,
+	}
+
+	// the Tenant ID is only used when Azure Active Directory authentication is enabled, defaulting to the provider's Tenant
+	if activeDirectoryAuth == servers.ActiveDirectoryAuthEnumEnabled {
+		tenantId := defaultTenantId
+		if t := v["tenant_id"].(string); t != "" {
+			tenantId = t
+		}
+		authConfig.TenantId = utils.String(tenantId)
+	}
+
+	return &authConfig
+}
+
+func flattenFlexibleServerAuthConfig(input *servers.AuthConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	activeDirectoryAuthEnabled := input.ActiveDirectoryAuth != nil && *input.ActiveDirectoryAuth == servers.ActiveDirectoryAuthEnumEnabled
+
+	// password authentication is enabled unless explicitly disabled
+	passwordAuthEnabled := input.PasswordAuth == nil || *input.PasswordAuth == servers.PasswordAuthEnumEnabled
+
+	tenantId := ""
+	if input.TenantId != nil {
+		tenantId = *input.TenantId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"active_directory_auth_enabled": activeDirectoryAuthEnabled,
+			"password_auth_enabled":         passwordAuthEnabled,
+			"tenant_id":                     tenantId,
+		},
+	}
+}
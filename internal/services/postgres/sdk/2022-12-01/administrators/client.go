@@ -0,0 +1,15 @@
+package administrators
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AdministratorsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAdministratorsClientWithBaseURI(endpoint string) AdministratorsClient {
+	return AdministratorsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,37 @@
+package administrators
+
+import "strings"
+
+type PrincipalType string
+
+const (
+	PrincipalTypeGroup            PrincipalType = "Group"
+	PrincipalTypeServicePrincipal PrincipalType = "ServicePrincipal"
+	PrincipalTypeUnknown          PrincipalType = "Unknown"
+	PrincipalTypeUser             PrincipalType = "User"
+)
+
+func PossibleValuesForPrincipalType() []string {
+	return []string{
+		string(PrincipalTypeGroup),
+		string(PrincipalTypeServicePrincipal),
+		string(PrincipalTypeUnknown),
+		string(PrincipalTypeUser),
+	}
+}
+
+func parsePrincipalType(input string) (*PrincipalType, error) {
+	vals := map[string]PrincipalType{
+		"group":            PrincipalTypeGroup,
+		"serviceprincipal": PrincipalTypeServicePrincipal,
+		"unknown":          PrincipalTypeUnknown,
+		"user":             PrincipalTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PrincipalType(input)
+	return &out, nil
+}
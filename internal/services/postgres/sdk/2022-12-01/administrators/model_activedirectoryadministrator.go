@@ -0,0 +1,13 @@
+package administrators
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type ActiveDirectoryAdministrator struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *AdministratorProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData   `json:"systemData,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
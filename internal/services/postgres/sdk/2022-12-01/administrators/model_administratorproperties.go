@@ -0,0 +1,8 @@
+package administrators
+
+type AdministratorProperties struct {
+	ObjectId      *string        `json:"objectId,omitempty"`
+	PrincipalName *string        `json:"principalName,omitempty"`
+	PrincipalType *PrincipalType `json:"principalType,omitempty"`
+	TenantId      *string        `json:"tenantId,omitempty"`
+}
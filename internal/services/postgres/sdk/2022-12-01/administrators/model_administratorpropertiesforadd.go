@@ -0,0 +1,7 @@
+package administrators
+
+type AdministratorPropertiesForAdd struct {
+	PrincipalName *string        `json:"principalName,omitempty"`
+	PrincipalType *PrincipalType `json:"principalType,omitempty"`
+	TenantId      *string        `json:"tenantId,omitempty"`
+}
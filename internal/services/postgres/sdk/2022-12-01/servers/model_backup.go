@@ -0,0 +1,7 @@
+package servers
+
+type Backup struct {
+	BackupRetentionDays *int64                  `json:"backupRetentionDays,omitempty"`
+	EarliestRestoreDate *string                 `json:"earliestRestoreDate,omitempty"`
+	GeoRedundantBackup  *GeoRedundantBackupEnum `json:"geoRedundantBackup,omitempty"`
+}
@@ -0,0 +1,8 @@
+package servers
+
+type ServerForUpdate struct {
+	Location   *string                    `json:"location,omitempty"`
+	Properties *ServerPropertiesForUpdate `json:"properties,omitempty"`
+	Sku        *Sku                       `json:"sku,omitempty"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+}
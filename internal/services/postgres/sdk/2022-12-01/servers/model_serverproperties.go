@@ -0,0 +1,22 @@
+package servers
+
+type ServerProperties struct {
+	AdministratorLogin         *string            `json:"administratorLogin,omitempty"`
+	AdministratorLoginPassword *string            `json:"administratorLoginPassword,omitempty"`
+	AuthConfig                 *AuthConfig        `json:"authConfig,omitempty"`
+	AvailabilityZone           *string            `json:"availabilityZone,omitempty"`
+	Backup                     *Backup            `json:"backup,omitempty"`
+	CreateMode                 *CreateMode        `json:"createMode,omitempty"`
+	FullyQualifiedDomainName   *string            `json:"fullyQualifiedDomainName,omitempty"`
+	HighAvailability           *HighAvailability  `json:"highAvailability,omitempty"`
+	MaintenanceWindow          *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	MinorVersion               *string            `json:"minorVersion,omitempty"`
+	Network                    *Network           `json:"network,omitempty"`
+	PointInTimeUTC             *string            `json:"pointInTimeUTC,omitempty"`
+	ReplicaCapacity            *int64             `json:"replicaCapacity,omitempty"`
+	ReplicationRole            *ReplicationRole   `json:"replicationRole,omitempty"`
+	SourceServerResourceId     *string            `json:"sourceServerResourceId,omitempty"`
+	State                      *ServerState       `json:"state,omitempty"`
+	Storage                    *Storage           `json:"storage,omitempty"`
+	Version                    *ServerVersion     `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package servers
+
+type Storage struct {
+	StorageSizeGB *int64 `json:"storageSizeGB,omitempty"`
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PrivateDnsResolverForwardingRuleId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	DnsForwardingRulesetName string
+	ForwardingRuleName       string
+}
+
+func NewPrivateDnsResolverForwardingRuleID(subscriptionId, resourceGroup, dnsForwardingRulesetName, forwardingRuleName string) PrivateDnsResolverForwardingRuleId {
+	return PrivateDnsResolverForwardingRuleId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		DnsForwardingRulesetName: dnsForwardingRulesetName,
+		ForwardingRuleName:       forwardingRuleName,
+	}
+}
+
+func (id PrivateDnsResolverForwardingRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Forwarding Rule Name %q", id.ForwardingRuleName),
+		fmt.Sprintf("Dns Forwarding Ruleset Name %q", id.DnsForwardingRulesetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Dns Resolver Forwarding Rule", segmentsStr)
+}
+
+func (id PrivateDnsResolverForwardingRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsForwardingRulesets/%s/forwardingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName)
+}
+
+// PrivateDnsResolverForwardingRuleID parses a PrivateDnsResolverForwardingRule ID into an PrivateDnsResolverForwardingRuleId struct
+func PrivateDnsResolverForwardingRuleID(input string) (*PrivateDnsResolverForwardingRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsResolverForwardingRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsForwardingRulesetName, err = id.PopSegment("dnsForwardingRulesets"); err != nil {
+		return nil, err
+	}
+	if resourceId.ForwardingRuleName, err = id.PopSegment("forwardingRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,240 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDnsResolverDnsForwardingRulesetResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverDnsForwardingRulesetResource{}
+
+type PrivateDnsResolverDnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	PrivateDnsResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Location                              string            `tfschema:"location"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &PrivateDnsResolverDnsForwardingRulesetModel{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverDnsForwardingRulesetID
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.PrivateDnsResolverOutboundEndpointID,
+			},
+		},
+
+		"location": commonschema.Location(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewPrivateDnsResolverDnsForwardingRulesetID(subscriptionId, model.ResourceGroupName, model.Name)
+			sdkId := dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := dnsforwardingrulesets.DnsForwardingRuleset{
+				Location: location.Normalize(model.Location),
+				Properties: dnsforwardingrulesets.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: expandPrivateDnsResolverOutboundEndpointIds(model.PrivateDnsResolverOutboundEndpointIds),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkId, properties, dnsforwardingrulesets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := dnsforwardingrulesets.DnsForwardingRulesetPatch{}
+
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				outboundEndpoints := expandPrivateDnsResolverOutboundEndpointIds(model.PrivateDnsResolverOutboundEndpointIds)
+				payload.DnsResolverOutboundEndpoints = &outboundEndpoints
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			sdkId := dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName)
+			if err := client.UpdateThenPoll(ctx, sdkId, payload, dnsforwardingrulesets.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverDnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				outboundEndpointIds, err := flattenPrivateDnsResolverOutboundEndpointIds(model.Properties.DnsResolverOutboundEndpoints)
+				if err != nil {
+					return err
+				}
+				state.PrivateDnsResolverOutboundEndpointIds = outboundEndpointIds
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := parse.PrivateDnsResolverDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName), dnsforwardingrulesets.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverOutboundEndpointIds(input []string) []dnsforwardingrulesets.SubResource {
+	output := make([]dnsforwardingrulesets.SubResource, 0)
+	for _, v := range input {
+		output = append(output, dnsforwardingrulesets.SubResource{
+			Id: v,
+		})
+	}
+
+	return output
+}
+
+func flattenPrivateDnsResolverOutboundEndpointIds(input []dnsforwardingrulesets.SubResource) ([]string, error) {
+	output := make([]string, 0)
+	for _, v := range input {
+		id, err := parse.PrivateDnsResolverOutboundEndpointID(v.Id)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, id.ID())
+	}
+
+	return output, nil
+}
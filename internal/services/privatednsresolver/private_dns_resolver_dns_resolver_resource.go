@@ -0,0 +1,204 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDnsResolverDnsResolverResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverDnsResolverResource{}
+
+type PrivateDnsResolverDnsResolverModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	VirtualNetworkId  string            `tfschema:"virtual_network_id"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDnsResolverDnsResolverResource) ResourceType() string {
+	return "azurerm_private_dns_resolver"
+}
+
+func (r PrivateDnsResolverDnsResolverResource) ModelObject() interface{} {
+	return &PrivateDnsResolverDnsResolverModel{}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverDnsResolverID
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.VirtualNetworkID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverDnsResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.DnsResolversClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewPrivateDnsResolverDnsResolverID(subscriptionId, model.ResourceGroupName, model.Name)
+			sdkId := dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := dnsresolvers.DnsResolver{
+				Location: location.Normalize(model.Location),
+				Properties: dnsresolvers.DnsResolverProperties{
+					VirtualNetwork: dnsresolvers.SubResource{
+						Id: model.VirtualNetworkId,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkId, properties, dnsresolvers.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsResolversClient
+
+			id, err := parse.PrivateDnsResolverDnsResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverDnsResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sdkId := dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName)
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := dnsresolvers.DnsResolverPatch{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, sdkId, payload, dnsresolvers.DefaultUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsResolversClient
+
+			id, err := parse.PrivateDnsResolverDnsResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverDnsResolverModel{
+				Name:              id.DnsResolverName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.VirtualNetworkId = model.Properties.VirtualNetwork.Id
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsResolverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsResolversClient
+
+			id, err := parse.PrivateDnsResolverDnsResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName), dnsresolvers.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
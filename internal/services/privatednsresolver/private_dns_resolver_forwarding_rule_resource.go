@@ -0,0 +1,298 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverForwardingRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverForwardingRuleResource{}
+
+type PrivateDnsResolverForwardingRuleModel struct {
+	Name                   string                 `tfschema:"name"`
+	DnsForwardingRulesetId string                 `tfschema:"dns_forwarding_ruleset_id"`
+	DomainName             string                 `tfschema:"domain_name"`
+	Enabled                bool                   `tfschema:"enabled"`
+	Metadata               map[string]string      `tfschema:"metadata"`
+	TargetDnsServers       []TargetDnsServerModel `tfschema:"target_dns_servers"`
+}
+
+type TargetDnsServerModel struct {
+	IPAddress string `tfschema:"ip_address"`
+	Port      int64  `tfschema:"port"`
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule"
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) ModelObject() interface{} {
+	return &PrivateDnsResolverForwardingRuleModel{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverForwardingRuleID
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverDnsForwardingRulesetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      53,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+			rulesetId, err := parse.PrivateDnsResolverDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroup, rulesetId.DnsForwardingRulesetName, model.Name)
+			sdkId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			state := forwardingrules.ForwardingRuleStateDisabled
+			if model.Enabled {
+				state = forwardingrules.ForwardingRuleStateEnabled
+			}
+
+			properties := forwardingrules.ForwardingRule{
+				Properties: forwardingrules.ForwardingRuleProperties{
+					DomainName:          model.DomainName,
+					ForwardingRuleState: &state,
+					Metadata:            &model.Metadata,
+					TargetDnsServers:    expandPrivateDnsResolverTargetDnsServers(model.TargetDnsServers),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, properties, forwardingrules.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := forwardingrules.ForwardingRulePatchProperties{}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				state := forwardingrules.ForwardingRuleStateDisabled
+				if model.Enabled {
+					state = forwardingrules.ForwardingRuleStateEnabled
+				}
+				properties.ForwardingRuleState = &state
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				properties.Metadata = &model.Metadata
+			}
+
+			if metadata.ResourceData.HasChange("target_dns_servers") {
+				targetDnsServers := expandPrivateDnsResolverTargetDnsServers(model.TargetDnsServers)
+				properties.TargetDnsServers = &targetDnsServers
+			}
+
+			payload := forwardingrules.ForwardingRulePatch{
+				Properties: &properties,
+			}
+
+			sdkId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName)
+			if _, err := client.Update(ctx, sdkId, payload, forwardingrules.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverForwardingRuleModel{
+				Name:                   id.ForwardingRuleName,
+				DnsForwardingRulesetId: parse.NewPrivateDnsResolverDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DomainName = props.DomainName
+				state.Enabled = props.ForwardingRuleState != nil && *props.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled
+				state.TargetDnsServers = flattenPrivateDnsResolverTargetDnsServers(props.TargetDnsServers)
+				if props.Metadata != nil {
+					state.Metadata = *props.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.PrivateDnsResolverForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.ForwardingRuleName), forwardingrules.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverTargetDnsServers(input []TargetDnsServerModel) []forwardingrules.TargetDnsServer {
+	output := make([]forwardingrules.TargetDnsServer, 0)
+	for _, v := range input {
+		output = append(output, forwardingrules.TargetDnsServer{
+			IPAddress: v.IPAddress,
+			Port:      utils.Int64(v.Port),
+		})
+	}
+
+	return output
+}
+
+func flattenPrivateDnsResolverTargetDnsServers(input []forwardingrules.TargetDnsServer) []TargetDnsServerModel {
+	output := make([]TargetDnsServerModel, 0)
+	for _, v := range input {
+		targetDnsServer := TargetDnsServerModel{
+			IPAddress: v.IPAddress,
+		}
+
+		if v.Port != nil {
+			targetDnsServer.Port = *v.Port
+		}
+
+		output = append(output, targetDnsServer)
+	}
+
+	return output
+}
@@ -0,0 +1,298 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverInboundEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverInboundEndpointResource{}
+
+type PrivateDnsResolverInboundEndpointModel struct {
+	Name                 string                                `tfschema:"name"`
+	PrivateDnsResolverId string                                `tfschema:"private_dns_resolver_id"`
+	IPConfigurations     []InboundEndpointIPConfigurationModel `tfschema:"ip_configurations"`
+	Location             string                                `tfschema:"location"`
+	Tags                 map[string]string                     `tfschema:"tags"`
+}
+
+type InboundEndpointIPConfigurationModel struct {
+	PrivateIPAddress          string `tfschema:"private_ip_address"`
+	PrivateIPAllocationMethod string `tfschema:"private_ip_allocation_method"`
+	SubnetId                  string `tfschema:"subnet_id"`
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_inbound_endpoint"
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDnsResolverInboundEndpointModel{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverInboundEndpointID
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverDnsResolverID,
+		},
+
+		"ip_configurations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"private_ip_allocation_method": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(inboundendpoints.IPAllocationMethodDynamic),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(inboundendpoints.IPAllocationMethodDynamic),
+							string(inboundendpoints.IPAllocationMethodStatic),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"location": commonschema.Location(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.InboundEndpointsClient
+			dnsResolverId, err := parse.PrivateDnsResolverDnsResolverID(model.PrivateDnsResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverInboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroup, dnsResolverId.DnsResolverName, model.Name)
+			sdkId := inboundendpoints.NewInboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.InboundEndpointName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ipConfigurations, err := expandPrivateDnsResolverIPConfigurations(model.IPConfigurations)
+			if err != nil {
+				return err
+			}
+
+			properties := inboundendpoints.InboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: inboundendpoints.InboundEndpointProperties{
+					IPConfigurations: ipConfigurations,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkId, properties, inboundendpoints.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.InboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sdkId := inboundendpoints.NewInboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.InboundEndpointName)
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := inboundendpoints.InboundEndpointPatch{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, sdkId, payload, inboundendpoints.DefaultUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.InboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, inboundendpoints.NewInboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.InboundEndpointName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverInboundEndpointModel{
+				Name:                 id.InboundEndpointName,
+				PrivateDnsResolverId: parse.NewPrivateDnsResolverDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.IPConfigurations = flattenPrivateDnsResolverIPConfigurations(model.Properties.IPConfigurations)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.InboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, inboundendpoints.NewInboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.InboundEndpointName), inboundendpoints.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverIPConfigurations(input []InboundEndpointIPConfigurationModel) ([]inboundendpoints.IPConfiguration, error) {
+	output := make([]inboundendpoints.IPConfiguration, 0)
+
+	for _, v := range input {
+		allocationMethod := inboundendpoints.IPAllocationMethod(v.PrivateIPAllocationMethod)
+		ipConfiguration := inboundendpoints.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: inboundendpoints.SubResource{
+				Id: v.SubnetId,
+			},
+		}
+
+		if allocationMethod == inboundendpoints.IPAllocationMethodStatic {
+			if v.PrivateIPAddress == "" {
+				return nil, fmt.Errorf("`private_ip_address` must be specified when `private_ip_allocation_method` is `%s`", allocationMethod)
+			}
+			ipConfiguration.PrivateIPAddress = utils.String(v.PrivateIPAddress)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output, nil
+}
+
+func flattenPrivateDnsResolverIPConfigurations(input []inboundendpoints.IPConfiguration) []InboundEndpointIPConfigurationModel {
+	output := make([]InboundEndpointIPConfigurationModel, 0)
+
+	for _, v := range input {
+		ipConfiguration := InboundEndpointIPConfigurationModel{
+			SubnetId: v.Subnet.Id,
+		}
+
+		if v.PrivateIPAddress != nil {
+			ipConfiguration.PrivateIPAddress = *v.PrivateIPAddress
+		}
+
+		if v.PrivateIPAllocationMethod != nil {
+			ipConfiguration.PrivateIPAllocationMethod = string(*v.PrivateIPAllocationMethod)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output
+}
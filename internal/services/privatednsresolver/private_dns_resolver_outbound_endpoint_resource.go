@@ -0,0 +1,212 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDnsResolverOutboundEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverOutboundEndpointResource{}
+
+type PrivateDnsResolverOutboundEndpointModel struct {
+	Name                 string            `tfschema:"name"`
+	PrivateDnsResolverId string            `tfschema:"private_dns_resolver_id"`
+	SubnetId             string            `tfschema:"subnet_id"`
+	Location             string            `tfschema:"location"`
+	Tags                 map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_outbound_endpoint"
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDnsResolverOutboundEndpointModel{}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverOutboundEndpointID
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverDnsResolverID,
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"location": commonschema.Location(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverOutboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.OutboundEndpointsClient
+			dnsResolverId, err := parse.PrivateDnsResolverDnsResolverID(model.PrivateDnsResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverOutboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroup, dnsResolverId.DnsResolverName, model.Name)
+			sdkId := outboundendpoints.NewOutboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.OutboundEndpointName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := outboundendpoints.OutboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: outboundendpoints.OutboundEndpointProperties{
+					Subnet: outboundendpoints.SubResource{
+						Id: model.SubnetId,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkId, properties, outboundendpoints.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.OutboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverOutboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sdkId := outboundendpoints.NewOutboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.OutboundEndpointName)
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := outboundendpoints.OutboundEndpointPatch{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, sdkId, payload, outboundendpoints.DefaultUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.OutboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, outboundendpoints.NewOutboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.OutboundEndpointName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverOutboundEndpointModel{
+				Name:                 id.OutboundEndpointName,
+				PrivateDnsResolverId: parse.NewPrivateDnsResolverDnsResolverID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.SubnetId = model.Properties.Subnet.Id
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.OutboundEndpointsClient
+
+			id, err := parse.PrivateDnsResolverOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, outboundendpoints.NewOutboundEndpointID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverName, id.OutboundEndpointName), outboundendpoints.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDnsResolverVirtualNetworkLinkResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverVirtualNetworkLinkResource{}
+
+type PrivateDnsResolverVirtualNetworkLinkModel struct {
+	Name                   string            `tfschema:"name"`
+	DnsForwardingRulesetId string            `tfschema:"dns_forwarding_ruleset_id"`
+	VirtualNetworkId       string            `tfschema:"virtual_network_id"`
+	Metadata               map[string]string `tfschema:"metadata"`
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_virtual_network_link"
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) ModelObject() interface{} {
+	return &PrivateDnsResolverVirtualNetworkLinkModel{}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateDnsResolverVirtualNetworkLinkID
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverDnsForwardingRulesetID,
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.VirtualNetworkID,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverVirtualNetworkLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.VirtualNetworkLinksClient
+			rulesetId, err := parse.PrivateDnsResolverDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPrivateDnsResolverVirtualNetworkLinkID(rulesetId.SubscriptionId, rulesetId.ResourceGroup, rulesetId.DnsForwardingRulesetName, model.Name)
+			sdkId := virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.VirtualNetworkLinkName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := virtualnetworklinks.VirtualNetworkLink{
+				Properties: virtualnetworklinks.VirtualNetworkLinkProperties{
+					Metadata: &model.Metadata,
+					VirtualNetwork: virtualnetworklinks.SubResource{
+						Id: model.VirtualNetworkId,
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkId, properties, virtualnetworklinks.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.VirtualNetworkLinksClient
+
+			id, err := parse.PrivateDnsResolverVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverVirtualNetworkLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sdkId := virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.VirtualNetworkLinkName)
+
+			if metadata.ResourceData.HasChange("metadata") {
+				payload := virtualnetworklinks.VirtualNetworkLinkPatch{
+					Properties: &virtualnetworklinks.VirtualNetworkLinkPatchProperties{
+						Metadata: &model.Metadata,
+					},
+				}
+				if err := client.UpdateThenPoll(ctx, sdkId, payload, virtualnetworklinks.DefaultUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.VirtualNetworkLinksClient
+
+			id, err := parse.PrivateDnsResolverVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.VirtualNetworkLinkName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverVirtualNetworkLinkModel{
+				Name:                   id.VirtualNetworkLinkName,
+				DnsForwardingRulesetId: parse.NewPrivateDnsResolverDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.VirtualNetworkId = model.Properties.VirtualNetwork.Id
+				if model.Properties.Metadata != nil {
+					state.Metadata = *model.Properties.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.VirtualNetworkLinksClient
+
+			id, err := parse.PrivateDnsResolverVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.VirtualNetworkLinkName), virtualnetworklinks.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package privatednsresolver
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/dns"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Private DNS Resolver",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Private DNS Resolver"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDnsResolverDnsResolverResource{},
+		PrivateDnsResolverInboundEndpointResource{},
+		PrivateDnsResolverOutboundEndpointResource{},
+		PrivateDnsResolverDnsForwardingRulesetResource{},
+		PrivateDnsResolverForwardingRuleResource{},
+		PrivateDnsResolverVirtualNetworkLinkResource{},
+	}
+}
@@ -0,0 +1,8 @@
+package privatednsresolver
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverDnsForwardingRuleset -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/dnsForwardingRuleset1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverDnsResolver -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsResolvers/dnsResolver1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverForwardingRule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/dnsForwardingRuleset1/forwardingRules/forwardingRule1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverInboundEndpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsResolvers/dnsResolver1/inboundEndpoints/inboundEndpoint1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverOutboundEndpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsResolvers/dnsResolver1/outboundEndpoints/outboundEndpoint1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PrivateDnsResolverVirtualNetworkLink -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/dnsForwardingRuleset1/virtualNetworkLinks/virtualNetworkLink1
@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -151,6 +152,20 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// larger files are streamed up as a series of blocks, rather than being read into memory in full
+	if info.Size() > maxSingleBlockBlobUploadSize {
+		if err := sbu.blockUploadFromSource(ctx, file, info.Size()); err != nil {
+			return fmt.Errorf("creating storage blob on Azure: %s", err)
+		}
+
+		return nil
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -364,6 +379,219 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	// Block Blobs larger than this are uploaded as a series of blocks which are then committed
+	maxSingleBlockBlobUploadSize int64 = 64 * 1024 * 1024
+
+	blockSize int64 = 16 * 1024 * 1024
+
+	// a Block Blob can be comprised of at most 50,000 blocks
+	maxBlockCount int64 = 50000
+)
+
+type storageBlobBlock struct {
+	id      string
+	md5     []byte
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	blockList, fileMD5, err := sbu.storageBlobBlockSplit(file, fileSize)
+	if err != nil {
+		return fmt.Errorf("splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	contentMD5 := base64.StdEncoding.EncodeToString(fileMD5)
+	if sbu.ContentMD5 != "" && sbu.ContentMD5 != contentMD5 {
+		return fmt.Errorf("the MD5 of the source file %q (%q) doesn't match the `content_md5` %q", sbu.Source, contentMD5, sbu.ContentMD5)
+	}
+
+	// blocks uploaded by a previous attempt which failed to commit are retained (uncommitted) for up to a week,
+	// since the Block ID contains the MD5 of the block these can be reused, rather than being uploaded again
+	uploaded, err := sbu.uncommittedBlockSizes(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving uncommitted blocks: %s", err)
+	}
+
+	pending := make([]storageBlobBlock, 0)
+	for _, block := range blockList {
+		if size, ok := uploaded[block.id]; ok && size == block.section.Size() {
+			continue
+		}
+		pending = append(pending, block)
+	}
+
+	if len(pending) > 0 {
+		workerCount := sbu.Parallelism
+		if workerCount < 1 {
+			workerCount = 1
+		}
+		if workerCount > len(pending) {
+			workerCount = len(pending)
+		}
+
+		blocks := make(chan storageBlobBlock, len(pending))
+		errors := make(chan error, len(pending))
+		wg := &sync.WaitGroup{}
+		wg.Add(len(pending))
+
+		for _, block := range pending {
+			blocks <- block
+		}
+		close(blocks)
+
+		for i := 0; i < workerCount; i++ {
+			go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+				blocks: blocks,
+				errors: errors,
+				wg:     wg,
+			})
+		}
+
+		wg.Wait()
+
+		if len(errors) > 0 {
+			return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+		}
+	}
+
+	blockIds := make([]blobs.BlockID, 0)
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: block.id,
+		})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentMD5:  utils.String(contentMD5),
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) uncommittedBlockSizes(ctx context.Context) (map[string]int64, error) {
+	output := make(map[string]int64)
+
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	}
+	resp, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		// the blob won't exist until the first upload attempt has been committed
+		if utils.ResponseWasNotFound(resp.Response) {
+			return output, nil
+		}
+
+		return nil, fmt.Errorf("GetBlockList: %s", err)
+	}
+
+	for _, block := range resp.UncommittedBlocks.Blocks {
+		output[block.Name] = block.Size
+	}
+
+	return output, nil
+}
+
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64) ([]storageBlobBlock, []byte, error) {
+	if fileSize > blockSize*maxBlockCount {
+		return nil, nil, fmt.Errorf("the file is %d bytes but Block Blobs can be at most %d bytes", fileSize, blockSize*maxBlockCount)
+	}
+
+	fileHash := md5.New()
+	blocks := make([]storageBlobBlock, 0)
+	for i, offset := 0, int64(0); offset < fileSize; i, offset = i+1, offset+blockSize {
+		size := blockSize
+		if remaining := fileSize - offset; remaining < size {
+			size = remaining
+		}
+
+		blockHash := md5.New()
+		if _, err := io.Copy(io.MultiWriter(fileHash, blockHash), io.NewSectionReader(file, offset, size)); err != nil {
+			return nil, nil, fmt.Errorf("Could not read block at %d: %s", offset, err)
+		}
+		blockMD5 := blockHash.Sum(nil)
+
+		// all of the Block ID's within a Blob must be the same length, so the index is zero-padded
+		blockId := fmt.Sprintf("%05d-%x", i, blockMD5)
+
+		blocks = append(blocks, storageBlobBlock{
+			id:      base64.StdEncoding.EncodeToString([]byte(blockId)),
+			md5:     blockMD5,
+			section: io.NewSectionReader(file, offset, size),
+		})
+	}
+
+	return blocks, fileHash.Sum(nil), nil
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		content := make([]byte, block.section.Size())
+		if _, err := io.ReadFull(block.section, content); err != nil {
+			uploadCtx.errors <- fmt.Errorf("reading block %q of source file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		// the source file could have been modified since it was split into blocks
+		if sum := md5.Sum(content); !bytes.Equal(sum[:], block.md5) {
+			uploadCtx.errors <- fmt.Errorf("the source file %q was modified during the upload", sbu.Source)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: content,
+		}
+		resp, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err != nil {
+			uploadCtx.errors <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		// the service returns the MD5 of the block it received, which confirms the block wasn't corrupted in transit
+		if expected := base64.StdEncoding.EncodeToString(block.md5); resp.ContentMD5 != "" && resp.ContentMD5 != expected {
+			uploadCtx.errors <- fmt.Errorf("the MD5 of block %q for file %q was %q but expected %q", block.id, sbu.Source, resp.ContentMD5, expected)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
+// fileMD5 returns the hex encoded MD5 of the contents of the file at the specified path
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
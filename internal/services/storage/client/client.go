@@ -26,6 +26,7 @@ import (
 
 type Client struct {
 	AccountsClient              *storage.AccountsClient
+	BlobContainersClient        *storage.BlobContainersClient
 	FileSystemsClient           *filesystems.Client
 	ADLSGen2PathsClient         *paths.Client
 	ManagementPoliciesClient    *storage.ManagementPoliciesClient
@@ -35,6 +36,7 @@ type Client struct {
 	EncryptionScopesClient      *storage.EncryptionScopesClient
 	Environment                 azure.Environment
 	FileServicesClient          *storage.FileServicesClient
+	LocalUsersClient            *storage.LocalUsersClient
 	ObjectReplicationClient     *objectreplicationpolicies.ObjectReplicationPoliciesClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
@@ -54,6 +56,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	adlsGen2PathsClient := paths.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&adlsGen2PathsClient.Client, options.StorageAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -72,6 +77,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	localUsersClient := storage.NewLocalUsersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&localUsersClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := objectreplicationpolicies.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
@@ -85,6 +93,7 @@ func NewClient(options *common.ClientOptions) *Client {
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
 		AccountsClient:              &accountsClient,
+		BlobContainersClient:        &blobContainersClient,
 		FileSystemsClient:           &fileSystemsClient,
 		ADLSGen2PathsClient:         &adlsGen2PathsClient,
 		ManagementPoliciesClient:    &managementPoliciesClient,
@@ -94,6 +103,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		EncryptionScopesClient:      &encryptionScopesClient,
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
+		LocalUsersClient:            &localUsersClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
@@ -153,6 +163,30 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	return &blobsClient, nil
 }
 
+// ContainersDataPlaneClient returns the Data Plane Containers Client, which is needed for
+// operations which aren't exposed through the StorageContainerWrapper (e.g. listing Blobs)
+func (client Client) ContainersDataPlaneClient(ctx context.Context, account accountDetails) (*containers.Client, error) {
+	if client.storageAdAuth != nil {
+		containersClient := containers.NewWithEnvironment(client.Environment)
+		containersClient.Client.Authorizer = *client.storageAdAuth
+		return &containersClient, nil
+	}
+
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	containersClient := containers.NewWithEnvironment(client.Environment)
+	containersClient.Client.Authorizer = storageAuth
+	return &containersClient, nil
+}
+
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
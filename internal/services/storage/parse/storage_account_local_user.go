@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageAccountLocalUserId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	LocalUserName      string
+}
+
+func NewStorageAccountLocalUserID(subscriptionId, resourceGroup, storageAccountName, localUserName string) StorageAccountLocalUserId {
+	return StorageAccountLocalUserId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		LocalUserName:      localUserName,
+	}
+}
+
+func (id StorageAccountLocalUserId) String() string {
+	segments := []string{
+		fmt.Sprintf("Local User Name %q", id.LocalUserName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Local User", segmentsStr)
+}
+
+func (id StorageAccountLocalUserId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/localUsers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+}
+
+// StorageAccountLocalUserID parses a StorageAccountLocalUser ID into an StorageAccountLocalUserId struct
+func StorageAccountLocalUserID(input string) (*StorageAccountLocalUserId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageAccountLocalUserId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.LocalUserName, err = id.PopSegment("localUsers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
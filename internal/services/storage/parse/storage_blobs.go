@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageBlobsId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	BlobServiceName    string
+	ContainerName      string
+	BlobSetName        string
+}
+
+func NewStorageBlobsID(subscriptionId, resourceGroup, storageAccountName, blobServiceName, containerName, blobSetName string) StorageBlobsId {
+	return StorageBlobsId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		BlobServiceName:    blobServiceName,
+		ContainerName:      containerName,
+		BlobSetName:        blobSetName,
+	}
+}
+
+func (id StorageBlobsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Blob Set Name %q", id.BlobSetName),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Blob Service Name %q", id.BlobServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Blobs", segmentsStr)
+}
+
+func (id StorageBlobsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/%s/containers/%s/blobSets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName, id.BlobSetName)
+}
+
+// StorageBlobsID parses a StorageBlobs ID into an StorageBlobsId struct
+func StorageBlobsID(input string) (*StorageBlobsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageBlobsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobSetName, err = id.PopSegment("blobSets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
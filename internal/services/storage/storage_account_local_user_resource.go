@@ -0,0 +1,452 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountLocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9]{3,64}$`),
+					"The name must be between 3 and 64 characters long and can only contain lowercase letters and numbers.",
+				),
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageAccountLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName, expandStorageAccountLocalUser(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the password can only be retrieved once, at the time it's generated
+	if d.Get("ssh_password_enabled").(bool) {
+		resp, err := client.RegeneratePassword(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+		if err != nil {
+			return fmt.Errorf("generating password for %s: %+v", id, err)
+		}
+		if resp.SSHPassword == nil {
+			return fmt.Errorf("generating password for %s: `sshPassword` was nil", id)
+		}
+		d.Set("password", resp.SSHPassword)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LocalUserName)
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	if props := resp.LocalUserProperties; props != nil {
+		d.Set("home_directory", props.HomeDirectory)
+		d.Set("sid", props.Sid)
+
+		if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+			return fmt.Errorf("setting `permission_scope`: %+v", err)
+		}
+
+		sshKeyEnabled := props.HasSSHKey != nil && *props.HasSSHKey
+		d.Set("ssh_key_enabled", sshKeyEnabled)
+		d.Set("ssh_password_enabled", props.HasSSHPassword != nil && *props.HasSSHPassword)
+
+		// the authorized keys aren't returned from the Get, so we have to list them separately
+		sshAuthorizedKeys := make([]interface{}, 0)
+		if sshKeyEnabled {
+			keys, err := client.ListKeys(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %+v", *id, err)
+			}
+			sshAuthorizedKeys = flattenStorageAccountLocalUserSSHAuthorizedKeys(keys.SSHAuthorizedKeys)
+		}
+		if err := d.Set("ssh_authorized_key", sshAuthorizedKeys); err != nil {
+			return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName, expandStorageAccountLocalUser(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		if d.Get("ssh_password_enabled").(bool) {
+			resp, err := client.RegeneratePassword(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+			if err != nil {
+				return fmt.Errorf("generating password for %s: %+v", *id, err)
+			}
+			if resp.SSHPassword == nil {
+				return fmt.Errorf("generating password for %s: `sshPassword` was nil", *id)
+			}
+			d.Set("password", resp.SSHPassword)
+		} else {
+			d.Set("password", "")
+		}
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountLocalUser(d *pluginsdk.ResourceData) storage.LocalUser {
+	props := storage.LocalUserProperties{
+		PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+		SSHAuthorizedKeys: expandStorageAccountLocalUserSSHAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+		HasSSHKey:         utils.Bool(d.Get("ssh_key_enabled").(bool)),
+		HasSSHPassword:    utils.Bool(d.Get("ssh_password_enabled").(bool)),
+	}
+
+	if v := d.Get("home_directory").(string); v != "" {
+		props.HomeDirectory = utils.String(v)
+	}
+
+	return storage.LocalUser{
+		LocalUserProperties: &props,
+	}
+}
+
+// the order of the permission characters is the order used by the service
+var storageAccountLocalUserPermissions = []struct {
+	key  string
+	flag string
+}{
+	{key: "read", flag: "r"},
+	{key: "write", flag: "w"},
+	{key: "delete", flag: "d"},
+	{key: "list", flag: "l"},
+	{key: "create", flag: "c"},
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]storage.PermissionScope {
+	output := make([]storage.PermissionScope, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		permissions := ""
+		if permissionsRaw := v["permissions"].([]interface{}); len(permissionsRaw) > 0 && permissionsRaw[0] != nil {
+			flags := permissionsRaw[0].(map[string]interface{})
+			for _, permission := range storageAccountLocalUserPermissions {
+				if flags[permission.key].(bool) {
+					permissions += permission.flag
+				}
+			}
+		}
+
+		output = append(output, storage.PermissionScope{
+			Permissions:  utils.String(permissions),
+			ResourceName: utils.String(v["resource_name"].(string)),
+			Service:      utils.String(v["service"].(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]storage.PermissionScope) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, scope := range *input {
+		flags := map[string]interface{}{}
+		permissions := ""
+		if scope.Permissions != nil {
+			permissions = *scope.Permissions
+		}
+		for _, permission := range storageAccountLocalUserPermissions {
+			flags[permission.key] = strings.Contains(permissions, permission.flag)
+		}
+
+		resourceName := ""
+		if scope.ResourceName != nil {
+			resourceName = *scope.ResourceName
+		}
+
+		service := ""
+		if scope.Service != nil {
+			service = *scope.Service
+		}
+
+		output = append(output, map[string]interface{}{
+			"permissions":   []interface{}{flags},
+			"resource_name": resourceName,
+			"service":       service,
+		})
+	}
+
+	return output
+}
+
+func expandStorageAccountLocalUserSSHAuthorizedKeys(input []interface{}) *[]storage.SSHPublicKey {
+	output := make([]storage.SSHPublicKey, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		key := storage.SSHPublicKey{
+			Key: utils.String(v["key"].(string)),
+		}
+		if description := v["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+
+		output = append(output, key)
+	}
+
+	return &output
+}
+
+func flattenStorageAccountLocalUserSSHAuthorizedKeys(input *[]storage.SSHPublicKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, key := range *input {
+		value := ""
+		if key.Key != nil {
+			value = *key.Key
+		}
+
+		description := ""
+		if key.Description != nil {
+			description = *key.Description
+		}
+
+		output = append(output, map[string]interface{}{
+			"key":         value,
+			"description": description,
+		})
+	}
+
+	return output
+}
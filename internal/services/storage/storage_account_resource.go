@@ -269,6 +269,18 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"local_user_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"nfsv3_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -378,6 +390,36 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.AccountImmutabilityPolicyStateDisabled),
+								string(storage.AccountImmutabilityPolicyStateLocked),
+								string(storage.AccountImmutabilityPolicyStateUnlocked),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"blob_properties": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -448,6 +490,22 @@ func resourceStorageAccount() *pluginsdk.Resource {
 								},
 							},
 						},
+
+						"restore_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"days": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+							RequiredWith: []string{"blob_properties.0.delete_retention_policy"},
+						},
 					},
 				},
 			},
@@ -923,8 +981,58 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
+
+				if d.HasChange("immutability_policy") {
+					oldRaw, newRaw := d.GetChange("immutability_policy")
+					oldPolicy, newPolicy := oldRaw.([]interface{}), newRaw.([]interface{})
+					if len(oldPolicy) > 0 && oldPolicy[0] != nil && len(newPolicy) > 0 && newPolicy[0] != nil {
+						oldValues, newValues := oldPolicy[0].(map[string]interface{}), newPolicy[0].(map[string]interface{})
+						if oldValues["state"].(string) == string(storage.AccountImmutabilityPolicyStateLocked) {
+							if newValues["state"].(string) != string(storage.AccountImmutabilityPolicyStateLocked) {
+								return fmt.Errorf("`immutability_policy.0.state` cannot be changed once it's been `Locked`")
+							}
+							if newValues["period_since_creation_in_days"].(int) < oldValues["period_since_creation_in_days"].(int) {
+								return fmt.Errorf("`immutability_policy.0.period_since_creation_in_days` can only be increased once `state` is `Locked`")
+							}
+							if newValues["allow_protected_append_writes"].(bool) != oldValues["allow_protected_append_writes"].(bool) {
+								return fmt.Errorf("`immutability_policy.0.allow_protected_append_writes` cannot be changed once `state` is `Locked`")
+							}
+						}
+					} else if len(oldPolicy) > 0 && oldPolicy[0] != nil {
+						if oldPolicy[0].(map[string]interface{})["state"].(string) == string(storage.AccountImmutabilityPolicyStateLocked) {
+							return fmt.Errorf("`immutability_policy` cannot be removed once its `state` is `Locked`")
+						}
+					}
+				}
+
+				if v, ok := d.GetOk("immutability_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					if d.Id() == "" && v.([]interface{})[0].(map[string]interface{})["state"].(string) == string(storage.AccountImmutabilityPolicyStateLocked) {
+						return fmt.Errorf("`immutability_policy.0.state` must be `Disabled` or `Unlocked` when creating a Storage Account, it can be `Locked` afterwards")
+					}
+					if !d.Get("blob_properties.0.versioning_enabled").(bool) {
+						return fmt.Errorf("`blob_properties.0.versioning_enabled` must be `true` when `immutability_policy` is specified")
+					}
+				}
+
+				if v, ok := d.GetOk("blob_properties.0.restore_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					if !d.Get("blob_properties.0.versioning_enabled").(bool) || !d.Get("blob_properties.0.change_feed_enabled").(bool) {
+						return fmt.Errorf("`versioning_enabled` and `change_feed_enabled` must be `true` when `restore_policy` is specified")
+					}
+
+					restoreDays := v.([]interface{})[0].(map[string]interface{})["days"].(int)
+					if deleteRetentionPolicy := d.Get("blob_properties.0.delete_retention_policy").([]interface{}); len(deleteRetentionPolicy) > 0 && deleteRetentionPolicy[0] != nil {
+						if deleteRetentionDays := deleteRetentionPolicy[0].(map[string]interface{})["days"].(int); restoreDays >= deleteRetentionDays {
+							return fmt.Errorf("`restore_policy.0.days` (%d) must be less than `delete_retention_policy.0.days` (%d)", restoreDays, deleteRetentionDays)
+						}
+					}
+				}
+
 				return nil
 			}),
+			// version-level immutability can only be enabled when the Storage Account is created and can't be disabled afterwards
+			pluginsdk.ForceNewIfChange("immutability_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
@@ -978,6 +1086,8 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	minimumTLSVersion := d.Get("min_tls_version").(string)
 	isHnsEnabled := d.Get("is_hns_enabled").(bool)
 	nfsV3Enabled := d.Get("nfsv3_enabled").(bool)
+	sftpEnabled := d.Get("sftp_enabled").(bool)
+	localUserEnabled := d.Get("local_user_enabled").(bool)
 	allowBlobPublicAccess := d.Get("allow_nested_items_to_be_public").(bool)
 	allowSharedKeyAccess := d.Get("shared_access_key_enabled").(bool)
 	defaultToOAuthAuthentication := d.Get("default_to_oauth_authentication").(bool)
@@ -1005,6 +1115,8 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			NetworkRuleSet:               expandStorageAccountNetworkRules(d, tenantId),
 			IsHnsEnabled:                 &isHnsEnabled,
 			EnableNfsV3:                  &nfsV3Enabled,
+			IsSftpEnabled:                &sftpEnabled,
+			IsLocalUserEnabled:           &localUserEnabled,
 			AllowSharedKeyAccess:         &allowSharedKeyAccess,
 			DefaultToOAuthAuthentication: &defaultToOAuthAuthentication,
 			AllowCrossTenantReplication:  &crossTenantReplication,
@@ -1043,6 +1155,10 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.CustomDomain = expandStorageAccountCustomDomain(d)
 	}
 
+	if v, ok := d.GetOk("immutability_policy"); ok {
+		parameters.ImmutableStorageWithVersioning = expandStorageAccountImmutabilityPolicy(v.([]interface{}))
+	}
+
 	// BlobStorage does not support ZRS
 	if accountKind == string(storage.KindBlobStorage) {
 		if string(parameters.Sku.Name) == string(storage.SkuNameStandardZRS) {
@@ -1074,6 +1190,10 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
 	}
 
+	if sftpEnabled && !isHnsEnabled {
+		return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+	}
+
 	// AccountTier must be Premium for FileStorage
 	if accountKind == string(storage.KindFileStorage) {
 		if string(parameters.Sku.Tier) == string(storage.SkuNameStandardLRS) {
@@ -1200,6 +1320,10 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 				blobProperties.ContainerDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(v.([]interface{}))
 			}
 
+			if v, ok := d.GetOk("blob_properties.0.restore_policy"); ok {
+				blobProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(v.([]interface{}))
+			}
+
 			if _, err = blobClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, *blobProperties); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `blob_properties` %q: %+v", id.Name, err)
 			}
@@ -1552,6 +1676,47 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("immutability_policy") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{})),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account immutability_policy %q: %+v", id.Name, err)
+		}
+	}
+
+	if d.HasChange("sftp_enabled") {
+		sftpEnabled := d.Get("sftp_enabled").(bool)
+		if sftpEnabled && !d.Get("is_hns_enabled").(bool) {
+			return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				IsSftpEnabled: utils.Bool(sftpEnabled),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account sftp_enabled %q: %+v", id.Name, err)
+		}
+	}
+
+	if d.HasChange("local_user_enabled") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				IsLocalUserEnabled: utils.Bool(d.Get("local_user_enabled").(bool)),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account local_user_enabled %q: %+v", id.Name, err)
+		}
+	}
+
 	if d.HasChange("routing") {
 		opts := storage.AccountUpdateParameters{
 			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -1619,6 +1784,10 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 				blobProperties.ContainerDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(d.Get("blob_properties.0.container_delete_retention_policy").([]interface{}))
 			}
 
+			if d.HasChange("blob_properties.0.restore_policy") {
+				blobProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(d.Get("blob_properties.0.restore_policy").([]interface{}))
+			}
+
 			if _, err = blobClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, *blobProperties); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `blob_properties` %q: %+v", id.Name, err)
 			}
@@ -1760,9 +1929,20 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if err := d.Set("routing", flattenArmStorageAccountRouting(props.RoutingPreference)); err != nil {
 			return fmt.Errorf("setting `routing`: %+v", err)
 		}
+		if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(props.ImmutableStorageWithVersioning)); err != nil {
+			return fmt.Errorf("setting `immutability_policy`: %+v", err)
+		}
 		d.Set("enable_https_traffic_only", props.EnableHTTPSTrafficOnly)
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
+		d.Set("sftp_enabled", props.IsSftpEnabled)
+
+		// local users are enabled by default when the API doesn't return a value
+		localUserEnabled := true
+		if props.IsLocalUserEnabled != nil {
+			localUserEnabled = *props.IsLocalUserEnabled
+		}
+		d.Set("local_user_enabled", localUserEnabled)
 
 		publicNetworkAccessEnabled := true
 		if props.PublicNetworkAccess == storage.PublicNetworkAccessDisabled {
@@ -2357,6 +2537,23 @@ func expandStorageAccountPrivateLinkAccess(inputs []interface{}, tenantId string
 	return &privateLinkAccess
 }
 
+func expandStorageAccountImmutabilityPolicy(input []interface{}) *storage.ImmutableStorageAccount {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &storage.ImmutableStorageAccount{
+		Enabled: utils.Bool(true),
+		ImmutabilityPolicy: &storage.AccountImmutabilityPolicyProperties{
+			AllowProtectedAppendWrites:            utils.Bool(v["allow_protected_append_writes"].(bool)),
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(v["period_since_creation_in_days"].(int))),
+			State:                                 storage.AccountImmutabilityPolicyState(v["state"].(string)),
+		},
+	}
+}
+
 func expandBlobProperties(input []interface{}) *storage.BlobServiceProperties {
 	props := storage.BlobServiceProperties{
 		BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
@@ -2417,6 +2614,22 @@ func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}) *storage.Del
 	}
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	result := storage.RestorePolicyProperties{
+		Enabled: utils.Bool(false),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return &result
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &storage.RestorePolicyProperties{
+		Enabled: utils.Bool(true),
+		Days:    utils.Int32(int32(policy["days"].(int))),
+	}
+}
+
 func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	blobCorsRules := storage.CorsRules{}
 
@@ -2828,6 +3041,30 @@ func flattenStorageAccountPrivateLinkAccess(inputs *[]storage.ResourceAccessRule
 	return accessRules
 }
 
+func flattenStorageAccountImmutabilityPolicy(input *storage.ImmutableStorageAccount) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled || input.ImmutabilityPolicy == nil {
+		return []interface{}{}
+	}
+
+	allowProtectedAppendWrites := false
+	if input.ImmutabilityPolicy.AllowProtectedAppendWrites != nil {
+		allowProtectedAppendWrites = *input.ImmutabilityPolicy.AllowProtectedAppendWrites
+	}
+
+	periodSinceCreationInDays := 0
+	if input.ImmutabilityPolicy.ImmutabilityPeriodSinceCreationInDays != nil {
+		periodSinceCreationInDays = int(*input.ImmutabilityPolicy.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_protected_append_writes": allowProtectedAppendWrites,
+			"period_since_creation_in_days": periodSinceCreationInDays,
+			"state":                         string(input.ImmutabilityPolicy.State),
+		},
+	}
+}
+
 func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 	if input.BlobServicePropertiesProperties == nil {
 		return []interface{}{}
@@ -2848,6 +3085,11 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedContainerDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(containerDeletePolicy)
 	}
 
+	flattenedRestorePolicy := make([]interface{}, 0)
+	if restorePolicy := input.BlobServicePropertiesProperties.RestorePolicy; restorePolicy != nil {
+		flattenedRestorePolicy = flattenBlobPropertiesRestorePolicy(restorePolicy)
+	}
+
 	versioning, changeFeedEnabled, changeFeedRetentionInDays := false, false, 0
 	if input.BlobServicePropertiesProperties.IsVersioningEnabled != nil {
 		versioning = *input.BlobServicePropertiesProperties.IsVersioningEnabled
@@ -2882,6 +3124,7 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 			"default_service_version":           defaultServiceVersion,
 			"last_access_time_enabled":          LastAccessTimeTrackingPolicy,
 			"container_delete_retention_policy": flattenedContainerDeletePolicy,
+			"restore_policy":                    flattenedRestorePolicy,
 		},
 	}
 }
@@ -2952,6 +3195,27 @@ func flattenBlobPropertiesDeleteRetentionPolicy(input *storage.DeleteRetentionPo
 	return deleteRetentionPolicy
 }
 
+func flattenBlobPropertiesRestorePolicy(input *storage.RestorePolicyProperties) []interface{} {
+	restorePolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return restorePolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		restorePolicy = append(restorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return restorePolicy
+}
+
 func flattenQueueProperties(input *queues.StorageServiceProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -1,8 +1,11 @@
 package storage
 
 import (
+	"context"
+	"crypto/md5"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
@@ -107,14 +110,12 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"source": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
 			"source_content": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source", "source_uri"},
 			},
 
@@ -128,7 +129,7 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
+				Computed:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
 
@@ -148,7 +149,65 @@ func resourceStorageBlob() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(storageBlobCustomizeDiff),
+	}
+}
+
+func storageBlobCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// only Block Blobs can be re-uploaded in-place, other Blob types have to be recreated when their contents change
+	if !strings.EqualFold(d.Get("type").(string), "Block") {
+		for _, key := range []string{"source", "source_content", "content_md5"} {
+			if d.HasChange(key) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// when `content_md5` isn't specified it's calculated from the source, so that changes to the contents
+	// of the local file (which otherwise wouldn't be detected) cause the Blob to be re-uploaded
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && !config.GetAttr("content_md5").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("source") || !d.NewValueKnown("source_content") {
+		return d.SetNewComputed("content_md5")
+	}
+
+	contentMD5 := ""
+	if source := d.Get("source").(string); source != "" {
+		// the file may not exist until it's generated during the apply
+		if _, err := os.Stat(source); os.IsNotExist(err) {
+			return d.SetNewComputed("content_md5")
+		}
+
+		hash, err := fileMD5(source)
+		if err != nil {
+			return fmt.Errorf("calculating the MD5 of `source`: %+v", err)
+		}
+		contentMD5 = hash
+	} else if content := d.Get("source_content").(string); content != "" {
+		contentMD5 = fmt.Sprintf("%x", md5.Sum([]byte(content)))
 	}
+
+	if contentMD5 == "" {
+		// the source has been removed, so the Blob will be re-uploaded empty
+		if d.HasChanges("source", "source_content") {
+			return d.SetNewComputed("content_md5")
+		}
+
+		return nil
+	}
+
+	if contentMD5 == d.Get("content_md5").(string) {
+		return nil
+	}
+
+	return d.SetNew("content_md5", contentMD5)
 }
 
 func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -249,7 +308,47 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
-	if d.HasChange("access_tier") {
+	reuploaded := false
+	if !d.IsNewResource() && d.HasChanges("source", "source_content", "content_md5") {
+		log.Printf("[DEBUG] Re-uploading Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		contentMD5 := ""
+		if v := d.Get("content_md5").(string); v != "" {
+			contentMD5, err = convertHexToBase64Encoding(v)
+			if err != nil {
+				return fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
+			}
+		}
+
+		blobInput := BlobUpload{
+			AccountName:   id.AccountName,
+			ContainerName: id.ContainerName,
+			BlobName:      id.BlobName,
+			Client:        blobsClient,
+
+			BlobType:      d.Get("type").(string),
+			CacheControl:  d.Get("cache_control").(string),
+			ContentType:   d.Get("content_type").(string),
+			ContentMD5:    contentMD5,
+			MetaData:      ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+			Parallelism:   d.Get("parallelism").(int),
+			Size:          d.Get("size").(int),
+			Source:        d.Get("source").(string),
+			SourceContent: d.Get("source_content").(string),
+		}
+		if err := blobInput.Create(ctx); err != nil {
+			return fmt.Errorf("re-uploading Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		reuploaded = true
+		log.Printf("[DEBUG] Re-uploaded Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	// re-uploading the Blob resets the Access Tier, so this needs to be set again if it's been configured
+	accessTierConfigured := false
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		accessTierConfigured = !config.GetAttr("access_tier").IsNull()
+	}
+
+	if d.HasChange("access_tier") || (reuploaded && accessTierConfigured) {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
@@ -261,14 +360,14 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated Access Tier for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
-	if d.HasChange("content_type") || d.HasChange("cache_control") {
+	if d.HasChange("content_type") || d.HasChange("cache_control") || reuploaded {
 		log.Printf("[DEBUG] Updating Properties for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		input := blobs.SetPropertiesInput{
 			ContentType:  utils.String(d.Get("content_type").(string)),
 			CacheControl: utils.String(d.Get("cache_control").(string)),
 		}
 
-		// `content_md5` is `ForceNew` but must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
+		// `content_md5` must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
 		if contentMD5 := d.Get("content_md5").(string); contentMD5 != "" {
 			data, err := convertHexToBase64Encoding(contentMD5)
 			if err != nil {
@@ -243,6 +243,101 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockFromLocalFileUpdated(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_md5").Exists(),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+		{
+			// changing the contents of the local file should cause the blob to be re-uploaded
+			PreConfig: func() {
+				if err := os.WriteFile(sourceBlob.Name(), []byte("updated contents"), 0o600); err != nil {
+					t.Fatalf("Error updating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLargeLocalFile(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	// files larger than 64MB are uploaded as a series of blocks
+	randomBytes := make([]byte, 100*1024*1024+512)
+	if _, err := rand.Read(randomBytes); err != nil {
+		t.Fatalf("Failed to read random bytes")
+	}
+	if _, err := sourceBlob.Write(randomBytes); err != nil {
+		t.Fatalf("Failed to write random bytes to file")
+	}
+	if err := sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromInlineContentUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromInlineContent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source_content", "type"),
+		{
+			Config: r.blockFromInlineContentUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_md5").HasValue("c6295f5f121a86722c45f5b763fe742b"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source_content", "type"),
+	})
+}
+
 func TestAccStorageBlob_cacheControl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -655,6 +750,25 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) blockFromInlineContentUpdated(data acceptance.TestData) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Wubba Lubba Dub Dub Updated"
+}
+`, template)
+}
+
 func (r StorageBlobResource) blockFromPublicBlob(data acceptance.TestData) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
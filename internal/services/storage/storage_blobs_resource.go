@@ -0,0 +1,627 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+func resourceStorageBlobs() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageBlobsCreate,
+		Read:   resourceStorageBlobsRead,
+		Update: resourceStorageBlobsUpdate,
+		Delete: resourceStorageBlobsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageBlobsID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageBlobsName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"storage_container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			"source_directory": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"include": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.StorageBlobsPattern,
+				},
+			},
+
+			"exclude": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.StorageBlobsPattern,
+				},
+			},
+
+			"delete_orphans": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"files": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(storageBlobsCustomizeDiff),
+	}
+}
+
+func storageBlobsCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source_directory") || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("files")
+	}
+
+	sourceDirectory := d.Get("source_directory").(string)
+
+	// the directory may not exist until it's generated during the apply
+	if _, err := os.Stat(sourceDirectory); os.IsNotExist(err) {
+		return d.SetNewComputed("files")
+	}
+
+	local, err := storageBlobsLocalFiles(sourceDirectory, d.Get("include").([]interface{}), d.Get("exclude").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	existing := d.Get("files").(map[string]interface{})
+	changed := len(existing) != len(local)
+	files := make(map[string]interface{})
+	for name, file := range local {
+		files[name] = file.md5
+		if v, ok := existing[name]; !ok || v.(string) != file.md5 {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return d.SetNew("files", files)
+}
+
+func resourceStorageBlobsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blobs (Container %q): %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	// there's no remote object representing a set of Blobs, so there's nothing to check for the presence of - any
+	// existing Blobs in the Container with the same names as the local files are overwritten
+	id := parse.NewStorageBlobsID(storageClient.SubscriptionId, account.ResourceGroup, accountName, "default", containerName, d.Get("name").(string))
+
+	log.Printf("[DEBUG] Synchronising Blobs into Container %q within Storage Account %q..", containerName, accountName)
+	files, err := syncStorageBlobs(ctx, d, blobsClient, containersClient, accountName, containerName)
+	if err != nil {
+		return fmt.Errorf("synchronising Blobs into Container %q (Account %q): %s", containerName, accountName, err)
+	}
+	log.Printf("[DEBUG] Synchronised Blobs into Container %q within Storage Account %q.", containerName, accountName)
+
+	d.SetId(id.ID())
+
+	// the planned value of `files` is unknown when `source_directory` didn't exist at plan time, so the Blobs which
+	// were synchronised are set here to ensure they're tracked
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("setting `files`: %+v", err)
+	}
+
+	return resourceStorageBlobsRead(d, meta)
+}
+
+func resourceStorageBlobsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blobs (Container %q): %s", id.StorageAccountName, id.ContainerName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Blobs (Container %q) - assuming removed & removing from state!", id.StorageAccountName, id.ContainerName)
+		d.SetId("")
+		return nil
+	}
+
+	containersWrapper, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	exists, err := containersWrapper.Exists(ctx, account.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("checking for existence of Container %q (Account %q): %s", id.ContainerName, id.StorageAccountName, err)
+	}
+	if exists == nil || !*exists {
+		log.Printf("[INFO] Container %q was not found in Account %q - assuming removed & removing Blobs from state...", id.ContainerName, id.StorageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	remote, err := listStorageBlobs(ctx, containersClient, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("listing Blobs in Container %q (Account %q): %s", id.ContainerName, id.StorageAccountName, err)
+	}
+
+	files := storageBlobsTrackedFiles(remote, d.Get("files").(map[string]interface{}), d.Get("delete_orphans").(bool))
+
+	d.Set("name", id.BlobSetName)
+	d.Set("storage_account_name", id.StorageAccountName)
+	d.Set("storage_container_name", id.ContainerName)
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("setting `files`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageBlobsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blobs (Container %q): %s", id.StorageAccountName, id.ContainerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.StorageAccountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Synchronising Blobs into Container %q within Storage Account %q..", id.ContainerName, id.StorageAccountName)
+	files, err := syncStorageBlobs(ctx, d, blobsClient, containersClient, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("synchronising Blobs into Container %q (Account %q): %s", id.ContainerName, id.StorageAccountName, err)
+	}
+	log.Printf("[DEBUG] Synchronised Blobs into Container %q within Storage Account %q.", id.ContainerName, id.StorageAccountName)
+
+	// as with Create, the planned value of `files` may be unknown
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("setting `files`: %+v", err)
+	}
+
+	return resourceStorageBlobsRead(d, meta)
+}
+
+func resourceStorageBlobsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blobs (Container %q): %s", id.StorageAccountName, id.ContainerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.StorageAccountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	// only the Blobs tracked by this resource are removed, any other Blobs in the Container are left as-is
+	for name := range d.Get("files").(map[string]interface{}) {
+		if err := deleteStorageBlob(ctx, blobsClient, id.StorageAccountName, id.ContainerName, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type storageBlobsLocalFile struct {
+	path string
+
+	// the hex encoded MD5 of the file's contents
+	md5 string
+}
+
+func storageBlobsLocalFiles(sourceDirectory string, includeRaw []interface{}, excludeRaw []interface{}) (map[string]storageBlobsLocalFile, error) {
+	include := *utils.ExpandStringSlice(includeRaw)
+	exclude := *utils.ExpandStringSlice(excludeRaw)
+
+	output := make(map[string]storageBlobsLocalFile)
+	err := filepath.WalkDir(sourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// symlinks are followed, but anything else which isn't a regular file (e.g. sockets) is skipped
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relativePath)
+
+		if len(include) > 0 && !storageBlobsPathMatches(include, name) {
+			return nil
+		}
+		if storageBlobsPathMatches(exclude, name) {
+			return nil
+		}
+
+		contentMD5, err := fileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		output[name] = storageBlobsLocalFile{
+			path: filePath,
+			md5:  contentMD5,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading the files within `source_directory` %q: %+v", sourceDirectory, err)
+	}
+
+	return output, nil
+}
+
+// storageBlobsPathMatches returns whether the (slash-separated) name matches any of the patterns - patterns
+// which don't contain a slash are matched against the file name, so that `*.html` matches in every directory
+func storageBlobsPathMatches(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		target := name
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
+		}
+
+		// the patterns are validated in the schema, so any error can be ignored
+		if matched, _ := path.Match(pattern, target); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// detectStorageBlobContentType determines the Content Type for a file based on its extension, falling back
+// to sniffing the contents of the file when the extension is unknown
+func detectStorageBlobContentType(filePath string) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(filePath)); contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", filePath, err)
+	}
+	defer file.Close()
+
+	// at most the first 512 bytes are considered when detecting the Content Type
+	buffer := make([]byte, 512)
+	n, err := file.Read(buffer)
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("reading %q: %+v", filePath, err)
+	}
+
+	return http.DetectContentType(buffer[:n]), nil
+}
+
+// storageBlobsTrackedFiles returns the Blobs within the Container (and their hex encoded MD5) which are tracked by
+// this resource - that's those which were synchronised, unless orphaned Blobs should be removed, in which case every
+// Blob in the Container is tracked so that any orphans are surfaced as a diff
+func storageBlobsTrackedFiles(remote map[string]string, synchronised map[string]interface{}, deleteOrphans bool) map[string]interface{} {
+	output := make(map[string]interface{})
+	for name, contentMD5 := range remote {
+		if _, managed := synchronised[name]; managed || deleteOrphans {
+			output[name] = contentMD5
+		}
+	}
+	return output
+}
+
+// storageBlobsSyncPlan compares the local files with those previously synchronised, returning the names of the files
+// which need uploading, the names of the Blobs which need removing and the files which are synchronised afterwards
+func storageBlobsSyncPlan(local map[string]storageBlobsLocalFile, existing map[string]interface{}) (pending []string, removed []string, synchronised map[string]interface{}) {
+	pending = make([]string, 0)
+	removed = make([]string, 0)
+	synchronised = make(map[string]interface{})
+
+	for name, file := range local {
+		synchronised[name] = file.md5
+		if v, ok := existing[name]; ok && v.(string) == file.md5 {
+			continue
+		}
+		pending = append(pending, name)
+	}
+
+	for name := range existing {
+		if _, ok := local[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(pending)
+	sort.Strings(removed)
+
+	return pending, removed, synchronised
+}
+
+// syncStorageBlobs uploads the local files to the Container and removes any Blobs which are no longer required,
+// returning the files which have been synchronised (keyed by name) with their hex encoded MD5
+func syncStorageBlobs(ctx context.Context, d *pluginsdk.ResourceData, blobsClient *blobs.Client, containersClient *containers.Client, accountName, containerName string) (map[string]interface{}, error) {
+	local, err := storageBlobsLocalFiles(d.Get("source_directory").(string), d.Get("include").([]interface{}), d.Get("exclude").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	oldRaw, _ := d.GetChange("files")
+	existing := oldRaw.(map[string]interface{})
+
+	pending, removed, synchronised := storageBlobsSyncPlan(local, existing)
+
+	if d.Get("delete_orphans").(bool) {
+		remote, err := listStorageBlobs(ctx, containersClient, accountName, containerName)
+		if err != nil {
+			return nil, fmt.Errorf("listing Blobs: %s", err)
+		}
+
+		for name := range remote {
+			_, isLocal := local[name]
+			_, isTracked := existing[name]
+			if !isLocal && !isTracked {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		workerCount := d.Get("parallelism").(int)
+		if workerCount > len(pending) {
+			workerCount = len(pending)
+		}
+
+		names := make(chan string, len(pending))
+		errors := make(chan error, len(pending))
+		wg := &sync.WaitGroup{}
+		wg.Add(len(pending))
+
+		for _, name := range pending {
+			names <- name
+		}
+		close(names)
+
+		for i := 0; i < workerCount; i++ {
+			go func() {
+				for name := range names {
+					if err := uploadStorageBlobsFile(ctx, blobsClient, accountName, containerName, name, local[name]); err != nil {
+						errors <- err
+					}
+					wg.Done()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if len(errors) > 0 {
+			return nil, <-errors
+		}
+	}
+
+	for _, name := range removed {
+		if err := deleteStorageBlob(ctx, blobsClient, accountName, containerName, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return synchronised, nil
+}
+
+func uploadStorageBlobsFile(ctx context.Context, client *blobs.Client, accountName, containerName, name string, file storageBlobsLocalFile) error {
+	contentType, err := detectStorageBlobContentType(file.path)
+	if err != nil {
+		return fmt.Errorf("detecting the Content Type for Blob %q: %s", name, err)
+	}
+
+	// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+	contentMD5, err := convertHexToBase64Encoding(file.md5)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Uploading Blob %q to Container %q within Storage Account %q..", name, containerName, accountName)
+	input := BlobUpload{
+		AccountName:   accountName,
+		ContainerName: containerName,
+		BlobName:      name,
+		Client:        client,
+
+		BlobType:    "Block",
+		ContentType: contentType,
+		ContentMD5:  contentMD5,
+		Parallelism: 1,
+		Source:      file.path,
+	}
+	if err := input.Create(ctx); err != nil {
+		return fmt.Errorf("uploading Blob %q from %q: %s", name, file.path, err)
+	}
+
+	return nil
+}
+
+func deleteStorageBlob(ctx context.Context, client *blobs.Client, accountName, containerName, name string) error {
+	log.Printf("[INFO] Deleting Blob %q from Container %q / Storage Account %q", name, containerName, accountName)
+	input := blobs.DeleteInput{
+		DeleteSnapshots: true,
+	}
+	if resp, err := client.Delete(ctx, accountName, containerName, name, input); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("deleting Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
+	}
+
+	return nil
+}
+
+// listStorageBlobs returns a map of the names of the Blobs within the Container to their hex encoded MD5
+func listStorageBlobs(ctx context.Context, client *containers.Client, accountName, containerName string) (map[string]string, error) {
+	output := make(map[string]string)
+
+	input := containers.ListBlobsInput{
+		MaxResults: utils.Int(5000),
+	}
+	for {
+		resp, err := client.ListBlobs(ctx, accountName, containerName, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blob := range resp.Blobs.Blobs {
+			contentMD5 := ""
+			if props := blob.Properties; props != nil && props.ContentMD5 != nil && *props.ContentMD5 != "" {
+				contentMD5, err = convertBase64ToHexEncoding(*props.ContentMD5)
+				if err != nil {
+					return nil, err
+				}
+			}
+			output[blob.Name] = contentMD5
+		}
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return output, nil
+}
@@ -0,0 +1,371 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type StorageBlobsResource struct{}
+
+func TestAccStorageBlobs_basic(t *testing.T) {
+	sourceDirectory := populateTempDirectory(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				data.CheckWithClient(r.blobHasContentType("index.html", "text/html; charset=utf-8")),
+			),
+		},
+		data.ImportStep("source_directory", "parallelism", "delete_orphans", "files"),
+	})
+}
+
+func TestAccStorageBlobs_include(t *testing.T) {
+	sourceDirectory := populateTempDirectory(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.include(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobs_update(t *testing.T) {
+	sourceDirectory := populateTempDirectory(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		{
+			// modifying, adding and removing local files should be reflected in the Container
+			PreConfig: func() {
+				if err := os.WriteFile(filepath.Join(sourceDirectory, "index.html"), []byte("<html><body>updated</body></html>"), 0o600); err != nil {
+					t.Fatalf("updating index.html: %+v", err)
+				}
+				if err := os.WriteFile(filepath.Join(sourceDirectory, "robots.txt"), []byte("User-agent: *"), 0o600); err != nil {
+					t.Fatalf("writing robots.txt: %+v", err)
+				}
+				if err := os.Remove(filepath.Join(sourceDirectory, "assets", "site.css")); err != nil {
+					t.Fatalf("removing site.css: %+v", err)
+				}
+			},
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.robots.txt").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobs_deleteOrphans(t *testing.T) {
+	sourceDirectory := populateTempDirectory(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				data.CheckWithClient(r.uploadBlob("orphan.txt")),
+			),
+		},
+		{
+			Config: r.deleteOrphans(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				data.CheckWithClient(r.blobDoesNotExist("orphan.txt")),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobs_multipleInContainer(t *testing.T) {
+	sourceDirectory := populateTempDirectory(t)
+	otherDirectory := t.TempDir()
+	if err := os.WriteFile(filepath.Join(otherDirectory, "robots.txt"), []byte("User-agent: *"), 0o600); err != nil {
+		t.Fatalf("writing robots.txt: %+v", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blobs", "test")
+	r := StorageBlobsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInContainer(data, sourceDirectory, otherDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That("azurerm_storage_blobs.other").ExistsInAzure(r),
+				check.That("azurerm_storage_blobs.other").Key("files.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("source_directory", "parallelism", "delete_orphans", "files"),
+	})
+}
+
+func (r StorageBlobsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageBlobsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for Blobs (Container %q)", id.StorageAccountName, id.ContainerName)
+	}
+	blobsClient, err := client.Storage.BlobsClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	for key, value := range state.Attributes {
+		if key == "files.%" || !strings.HasPrefix(key, "files.") {
+			continue
+		}
+
+		name := strings.TrimPrefix(key, "files.")
+		props, err := blobsClient.GetProperties(ctx, id.StorageAccountName, id.ContainerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			if utils.ResponseWasNotFound(props.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", name, id.ContainerName, id.StorageAccountName, err)
+		}
+
+		if value == "" {
+			return nil, fmt.Errorf("expected the MD5 of Blob %q to be tracked", name)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageBlobsResource) blobHasContentType(name, contentType string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		accountName := state.Attributes["storage_account_name"]
+		containerName := state.Attributes["storage_container_name"]
+
+		account, err := clients.Storage.FindAccount(ctx, accountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+		}
+
+		client, err := clients.Storage.BlobsClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Blobs Client: %s", err)
+		}
+
+		props, err := client.GetProperties(ctx, accountName, containerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			return fmt.Errorf("retrieving Properties for Blob %q (Container %q): %s", name, containerName, err)
+		}
+
+		if props.ContentType != contentType {
+			return fmt.Errorf("expected the Content Type of Blob %q to be %q but got %q", name, contentType, props.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobsResource) uploadBlob(name string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		accountName := state.Attributes["storage_account_name"]
+		containerName := state.Attributes["storage_container_name"]
+
+		account, err := clients.Storage.FindAccount(ctx, accountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+		}
+
+		client, err := clients.Storage.BlobsClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Blobs Client: %s", err)
+		}
+
+		content := []byte("orphaned")
+		input := blobs.PutBlockBlobInput{
+			Content: &content,
+		}
+		if _, err := client.PutBlockBlob(ctx, accountName, containerName, name, input); err != nil {
+			return fmt.Errorf("uploading Blob %q (Container %q): %s", name, containerName, err)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobsResource) blobDoesNotExist(name string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		accountName := state.Attributes["storage_account_name"]
+		containerName := state.Attributes["storage_container_name"]
+
+		account, err := clients.Storage.FindAccount(ctx, accountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+		}
+
+		client, err := clients.Storage.BlobsClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Blobs Client: %s", err)
+		}
+
+		props, err := client.GetProperties(ctx, accountName, containerName, name, blobs.GetPropertiesInput{})
+		if err == nil {
+			return fmt.Errorf("expected Blob %q (Container %q) to have been deleted", name, containerName)
+		}
+		if !utils.ResponseWasNotFound(props.Response) {
+			return fmt.Errorf("retrieving Properties for Blob %q (Container %q): %s", name, containerName, err)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "content"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageBlobsResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  name                   = "website"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+}
+`, r.template(data), filepath.ToSlash(sourceDirectory))
+}
+
+func (r StorageBlobsResource) include(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  name                   = "website"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+  include                = ["*.html", "assets/*"]
+  exclude                = ["assets/*.css"]
+}
+`, r.template(data), filepath.ToSlash(sourceDirectory))
+}
+
+func (r StorageBlobsResource) deleteOrphans(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "test" {
+  name                   = "website"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+  delete_orphans         = true
+}
+`, r.template(data), filepath.ToSlash(sourceDirectory))
+}
+
+func (r StorageBlobsResource) multipleInContainer(data acceptance.TestData, sourceDirectory, otherDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blobs" "other" {
+  name                   = "other"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+}
+`, r.basic(data, sourceDirectory), filepath.ToSlash(otherDirectory))
+}
+
+func populateTempDirectory(t *testing.T) string {
+	directory := t.TempDir()
+
+	files := map[string]string{
+		"index.html":                            "<html><body>hello world</body></html>",
+		filepath.Join("assets", "site.css"):     "body { color: #000000; }",
+		filepath.Join("assets", "data.unknown"): "some plain text contents",
+	}
+	for name, contents := range files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+
+	return directory
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStorageBlobsSyncPlan(t *testing.T) {
+	local := map[string]storageBlobsLocalFile{
+		"index.html":      {path: "index.html", md5: "aaa"},
+		"css/style.css":   {path: "css/style.css", md5: "bbb"},
+		"js/unchanged.js": {path: "js/unchanged.js", md5: "ccc"},
+	}
+
+	testData := []struct {
+		Name                 string
+		Existing             map[string]interface{}
+		ExpectedPending      []string
+		ExpectedRemoved      []string
+		ExpectedSynchronised map[string]interface{}
+	}{
+		{
+			// when `files` is unknown at plan time (e.g. `source_directory` is generated during the apply)
+			// there's nothing in the existing state, so every local file is uploaded and tracked
+			Name:                 "Computed Files",
+			Existing:             map[string]interface{}{},
+			ExpectedPending:      []string{"css/style.css", "index.html", "js/unchanged.js"},
+			ExpectedRemoved:      []string{},
+			ExpectedSynchronised: map[string]interface{}{"index.html": "aaa", "css/style.css": "bbb", "js/unchanged.js": "ccc"},
+		},
+		{
+			Name: "Changed, Unchanged and Removed Files",
+			Existing: map[string]interface{}{
+				"index.html":      "old",
+				"js/unchanged.js": "ccc",
+				"robots.txt":      "ddd",
+			},
+			ExpectedPending:      []string{"css/style.css", "index.html"},
+			ExpectedRemoved:      []string{"robots.txt"},
+			ExpectedSynchronised: map[string]interface{}{"index.html": "aaa", "css/style.css": "bbb", "js/unchanged.js": "ccc"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		pending, removed, synchronised := storageBlobsSyncPlan(local, v.Existing)
+		if !reflect.DeepEqual(v.ExpectedPending, pending) {
+			t.Fatalf("expected pending %v but got %v", v.ExpectedPending, pending)
+		}
+		if !reflect.DeepEqual(v.ExpectedRemoved, removed) {
+			t.Fatalf("expected removed %v but got %v", v.ExpectedRemoved, removed)
+		}
+		if !reflect.DeepEqual(v.ExpectedSynchronised, synchronised) {
+			t.Fatalf("expected synchronised %v but got %v", v.ExpectedSynchronised, synchronised)
+		}
+	}
+}
+
+func TestStorageBlobsTrackedFiles(t *testing.T) {
+	remote := map[string]string{
+		"index.html":         "aaa",
+		"css/style.css":      "bbb",
+		"other-set/logo.png": "eee",
+	}
+
+	testData := []struct {
+		Name          string
+		Synchronised  map[string]interface{}
+		DeleteOrphans bool
+		Expected      map[string]interface{}
+	}{
+		{
+			Name:         "Synchronised Files Are Tracked",
+			Synchronised: map[string]interface{}{"index.html": "aaa", "css/style.css": "bbb"},
+			Expected:     map[string]interface{}{"index.html": "aaa", "css/style.css": "bbb"},
+		},
+		{
+			Name:         "Remote Checksum Is Used",
+			Synchronised: map[string]interface{}{"index.html": "stale"},
+			Expected:     map[string]interface{}{"index.html": "aaa"},
+		},
+		{
+			Name:         "Synchronised Files Which No Longer Exist Are Dropped",
+			Synchronised: map[string]interface{}{"index.html": "aaa", "robots.txt": "ddd"},
+			Expected:     map[string]interface{}{"index.html": "aaa"},
+		},
+		{
+			Name:          "Orphans Are Tracked When Deleting Orphans",
+			Synchronised:  map[string]interface{}{"index.html": "aaa"},
+			DeleteOrphans: true,
+			Expected:      map[string]interface{}{"index.html": "aaa", "css/style.css": "bbb", "other-set/logo.png": "eee"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := storageBlobsTrackedFiles(remote, v.Synchronised, v.DeleteOrphans)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func TestStorageBlobsComputedFilesAreTracked(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	for name, contents := range map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body {}",
+	} {
+		filePath := filepath.Join(sourceDirectory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+
+	local, err := storageBlobsLocalFiles(sourceDirectory, []interface{}{}, []interface{}{})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	// the planned `files` is unknown, which is read back as an empty map
+	_, _, synchronised := storageBlobsSyncPlan(local, map[string]interface{}{})
+
+	// the Container also holds a Blob which isn't managed by this resource
+	remote := map[string]string{
+		"unmanaged.txt": "fff",
+	}
+	for name, file := range local {
+		remote[name] = file.md5
+	}
+
+	actual := storageBlobsTrackedFiles(remote, synchronised, false)
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 files to be tracked but got %d: %v", len(actual), actual)
+	}
+	for name, file := range local {
+		if actual[name] != file.md5 {
+			t.Fatalf("expected %q to be tracked with MD5 %q but got %v", name, file.md5, actual[name])
+		}
+	}
+	if _, ok := actual["unmanaged.txt"]; ok {
+		t.Fatalf("expected `unmanaged.txt` not to be tracked")
+	}
+}
@@ -0,0 +1,311 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const storageContainerImmutabilityPolicyName = "default"
+
+func resourceStorageContainerImmutabilityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerImmutabilityPolicyCreate,
+		Read:   resourceStorageContainerImmutabilityPolicyRead,
+		Update: resourceStorageContainerImmutabilityPolicyUpdate,
+		Delete: resourceStorageContainerImmutabilityPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerImmutabilityPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+			},
+
+			"immutability_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"locked": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protected_append_writes_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"protected_append_writes_all_enabled"},
+			},
+
+			"protected_append_writes_all_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"protected_append_writes_enabled"},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				// a locked policy can't be unlocked, shortened or have its protected append writes changed
+				if d.Id() == "" {
+					return nil
+				}
+
+				oldLocked, newLocked := d.GetChange("locked")
+				if !oldLocked.(bool) {
+					return nil
+				}
+
+				if !newLocked.(bool) {
+					return fmt.Errorf("`locked` cannot be changed from `true` to `false` once the Immutability Policy has been locked")
+				}
+
+				oldPeriod, newPeriod := d.GetChange("immutability_period_in_days")
+				if newPeriod.(int) < oldPeriod.(int) {
+					return fmt.Errorf("`immutability_period_in_days` can only be increased once the Immutability Policy has been locked")
+				}
+
+				if d.HasChange("protected_append_writes_enabled") || d.HasChange("protected_append_writes_all_enabled") {
+					return fmt.Errorf("`protected_append_writes_enabled` and `protected_append_writes_all_enabled` cannot be changed once the Immutability Policy has been locked")
+				}
+
+				return nil
+			}),
+		),
+	}
+}
+
+func resourceStorageContainerImmutabilityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, storageContainerImmutabilityPolicyName)
+
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *containerId, err)
+	}
+	if props := container.ContainerProperties; props != nil && props.HasImmutabilityPolicy != nil && *props.HasImmutabilityPolicy {
+		return tf.ImportAsExistsError("azurerm_storage_container_immutability_policy", id.ID())
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: expandStorageContainerImmutabilityPolicy(d),
+	}
+
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if d.Get("locked").(bool) {
+		if resp.Etag == nil {
+			return fmt.Errorf("locking %s: `etag` was nil", id)
+		}
+
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(container.Response) {
+			log.Printf("[INFO] Storage Container %q does not exist - removing %s from state", id.ContainerName, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Storage Container %q for %s: %+v", id.ContainerName, id, err)
+	}
+	if props := container.ContainerProperties; props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy {
+		log.Printf("[INFO] %s does not exist - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("storage_container_resource_manager_id", parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName).ID())
+
+	if props := resp.ImmutabilityPolicyProperty; props != nil {
+		immutabilityPeriod := 0
+		if props.ImmutabilityPeriodSinceCreationInDays != nil {
+			immutabilityPeriod = int(*props.ImmutabilityPeriodSinceCreationInDays)
+		}
+		d.Set("immutability_period_in_days", immutabilityPeriod)
+		d.Set("locked", props.State == storage.ImmutabilityPolicyStateLocked)
+		d.Set("protected_append_writes_enabled", props.AllowProtectedAppendWrites != nil && *props.AllowProtectedAppendWrites)
+		d.Set("protected_append_writes_all_enabled", props.AllowProtectedAppendWritesAll != nil && *props.AllowProtectedAppendWritesAll)
+	}
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", id)
+	}
+	etag := *existing.Etag
+
+	isLocked := existing.ImmutabilityPolicyProperty != nil && existing.ImmutabilityPolicyProperty.State == storage.ImmutabilityPolicyStateLocked
+
+	if isLocked {
+		// once locked the only permitted change is to extend the immutability period
+		if d.HasChange("immutability_period_in_days") {
+			policy := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+				},
+			}
+			if _, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag, &policy); err != nil {
+				return fmt.Errorf("extending %s: %+v", id, err)
+			}
+		}
+
+		return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+	}
+
+	if d.HasChanges("immutability_period_in_days", "protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+		policy := storage.ImmutabilityPolicy{
+			ImmutabilityPolicyProperty: expandStorageContainerImmutabilityPolicy(d),
+		}
+		resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, etag)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+		if resp.Etag == nil {
+			return fmt.Errorf("updating %s: `etag` was nil", id)
+		}
+		etag = *resp.Etag
+	}
+
+	if d.Get("locked").(bool) {
+		if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag); err != nil {
+			return fmt.Errorf("locking %s: %+v", id, err)
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+	locks.ByID(containerId.ID())
+	defer locks.UnlockByID(containerId.ID())
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Etag == nil {
+		return fmt.Errorf("retrieving %s: `etag` was nil", id)
+	}
+
+	if props := existing.ImmutabilityPolicyProperty; props != nil && props.State == storage.ImmutabilityPolicyStateLocked {
+		return fmt.Errorf("deleting %s: a locked Immutability Policy cannot be deleted", id)
+	}
+
+	if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandStorageContainerImmutabilityPolicy(d *pluginsdk.ResourceData) *storage.ImmutabilityPolicyProperty {
+	return &storage.ImmutabilityPolicyProperty{
+		ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+		AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+		AllowProtectedAppendWritesAll:         utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+	}
+}
@@ -0,0 +1,213 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageContainerLegalHold() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerLegalHoldCreate,
+		Read:   resourceStorageContainerLegalHoldRead,
+		Update: resourceStorageContainerLegalHoldUpdate,
+		Delete: resourceStorageContainerLegalHoldDelete,
+
+		// the Legal Hold is a property of the Storage Container, so it's identified by the Container's Resource Manager ID
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerResourceManagerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					// the service normalizes tags to lower case, so only accept lower case to avoid a perpetual diff
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[a-z0-9]{3,23}$`),
+						"tags must be between 3 and 23 lower case alphanumeric characters",
+					),
+				},
+			},
+
+			"protected_append_writes_all_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceStorageContainerLegalHoldCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if props := existing.ContainerProperties; props != nil && props.HasLegalHold != nil && *props.HasLegalHold {
+		return tf.ImportAsExistsError("azurerm_storage_container_legal_hold", id.ID())
+	}
+
+	legalHold := storage.LegalHold{
+		Tags:                          utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+		AllowProtectedAppendWritesAll: utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing Legal Hold from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.ContainerProperties
+	if props == nil || props.HasLegalHold == nil || !*props.HasLegalHold || props.LegalHold == nil {
+		log.Printf("[INFO] Legal Hold for %s does not exist - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", id.ID())
+
+	tags := make([]interface{}, 0)
+	if props.LegalHold.Tags != nil {
+		for _, tag := range *props.LegalHold.Tags {
+			if tag.Tag != nil {
+				tags = append(tags, *tag.Tag)
+			}
+		}
+	}
+	if err := d.Set("tags", pluginsdk.NewSet(pluginsdk.HashString, tags)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	protectedAppendWritesAll := false
+	if history := props.LegalHold.ProtectedAppendWritesHistory; history != nil && history.AllowProtectedAppendWritesAll != nil {
+		protectedAppendWritesAll = *history.AllowProtectedAppendWritesAll
+	}
+	d.Set("protected_append_writes_all_enabled", protectedAppendWritesAll)
+
+	return nil
+}
+
+func resourceStorageContainerLegalHoldUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	if d.HasChange("tags") {
+		oldRaw, newRaw := d.GetChange("tags")
+		removed := oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set))
+		if removed.Len() > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(removed.List()),
+			}
+			if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("clearing Legal Hold tags for %s: %+v", *id, err)
+			}
+		}
+	}
+
+	legalHold := storage.LegalHold{
+		Tags:                          utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+		AllowProtectedAppendWritesAll: utils.Bool(d.Get("protected_append_writes_all_enabled").(bool)),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+	}
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+	}
+	if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+	}
+
+	return nil
+}
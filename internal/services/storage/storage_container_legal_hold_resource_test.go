@@ -0,0 +1,156 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageContainerLegalHoldResource struct{}
+
+func TestAccStorageContainerLegalHold_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_legal_hold", "test")
+	r := StorageContainerLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainerLegalHold_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_legal_hold", "test")
+	r := StorageContainerLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageContainerLegalHold_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_legal_hold", "test")
+	r := StorageContainerLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageContainerLegalHoldResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageContainerResourceManagerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.BlobContainersClient.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ContainerProperties != nil && resp.ContainerProperties.HasLegalHold != nil && *resp.ContainerProperties.HasLegalHold), nil
+}
+
+func (r StorageContainerLegalHoldResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageContainerLegalHoldResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_legal_hold" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  tags                                  = ["litigation1"]
+}
+`, r.template(data))
+}
+
+func (r StorageContainerLegalHoldResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_legal_hold" "import" {
+  storage_container_resource_manager_id = azurerm_storage_container_legal_hold.test.storage_container_resource_manager_id
+  tags                                  = azurerm_storage_container_legal_hold.test.tags
+}
+`, r.basic(data))
+}
+
+func (r StorageContainerLegalHoldResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_legal_hold" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  tags                                  = ["litigation1", "audit2022"]
+  protected_append_writes_all_enabled   = true
+}
+`, r.template(data))
+}
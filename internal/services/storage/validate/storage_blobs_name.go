@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageBlobsName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 80 characters, contain only letters, numbers, hyphens, underscores and periods, and begin and end with a letter or number: %q",
+			k, value))
+	}
+	return warnings, errors
+}
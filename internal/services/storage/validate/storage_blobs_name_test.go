@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateStorageBlobsName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"website",
+		"static-site_v1.2",
+		strings.Repeat("w", 80),
+	}
+	for _, v := range validNames {
+		_, errors := StorageBlobsName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Storage Blobs Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-website",
+		"website.",
+		"web/site",
+		"web site",
+		strings.Repeat("w", 81),
+	}
+	for _, v := range invalidNames {
+		if _, errors := StorageBlobsName(v, "name"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Storage Blobs Name", v)
+		}
+	}
+}
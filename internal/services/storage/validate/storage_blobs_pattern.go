@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"fmt"
+	"path"
+)
+
+func StorageBlobsPattern(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid pattern: %q", k, value))
+	}
+	return warnings, errors
+}
@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestStorageBlobsPattern(t *testing.T) {
+	validPatterns := []string{
+		"*.html",
+		"assets/*",
+		"images/*.[pj]ng",
+		"index.html",
+	}
+	for _, v := range validPatterns {
+		_, errors := StorageBlobsPattern(v, "include")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"",
+		"[",
+		"assets/[a-",
+	}
+	for _, v := range invalidPatterns {
+		if _, errors := StorageBlobsPattern(v, "include"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid pattern", v)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package web
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
@@ -151,7 +151,7 @@ func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interfa
 		},
 		MinTimeout: 1 * time.Minute,
 		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
-		Refresh:    appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+		Refresh:    helpers.AppServiceEnvironmentRefreshFunc(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
 	}
 
 	// as such we'll ignore it and use a custom poller instead
@@ -218,7 +218,7 @@ func resourceAppServiceEnvironmentUpdate(d *pluginsdk.ResourceData, meta interfa
 		},
 		MinTimeout: 1 * time.Minute,
 		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
-		Refresh:    appServiceEnvironmentRefresh(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
+		Refresh:    helpers.AppServiceEnvironmentRefreshFunc(ctx, client, id.ResourceGroup, id.HostingEnvironmentName),
 	}
 
 	if _, err := updateWait.WaitForStateContext(ctx); err != nil {
@@ -323,22 +323,6 @@ func resourceAppServiceEnvironmentDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
-func appServiceEnvironmentRefresh(ctx context.Context, client *web.AppServiceEnvironmentsClient, resourceGroup string, name string) pluginsdk.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		read, err := client.Get(ctx, resourceGroup, name)
-		if err != nil {
-			return "", "", err
-		}
-
-		if read.AppServiceEnvironment == nil {
-			return "", "", fmt.Errorf("`properties` was nil")
-		}
-
-		state := read.AppServiceEnvironment.ProvisioningState
-		return state, string(state), nil
-	}
-}
-
 // Note: These are abstractions and possibly subject to change if Azure changes the underlying SKU for Isolated instances.
 func convertFromIsolatedSKU(isolated string) (vmSKU string) {
 	switch isolated {
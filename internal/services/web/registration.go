@@ -59,13 +59,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{
-		AppServiceEnvironmentV3DataSource{},
-	}
+	return []sdk.DataSource{}
 }
 
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{
-		AppServiceEnvironmentV3Resource{},
-	}
+	return []sdk.Resource{}
 }